@@ -27,6 +27,7 @@ import (
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/manager"
+	"github.com/northwesternmutual/grammes/query/dialect"
 )
 
 // maxConCurrentMessages determines the size of the request channel.
@@ -60,6 +61,12 @@ type Client struct {
 	broken bool
 	// logger is used to log out debug statements and errors from the client.
 	logger logging.Logger
+	// strict is used to validate traversals before sending them.
+	strict bool
+	// schemaValidation is used to check property values against the schema.
+	schemaValidation bool
+	// dialect is the Gremlin provider that queries are rendered for.
+	dialect dialect.Dialect
 }
 
 // setupClient default values some fields in the client.
@@ -93,7 +100,12 @@ func Dial(conn gremconnect.Dialer, cfgs ...ClientConfiguration) (*Client, error)
 	}
 
 	// GraphManager should be set because it's after the connection is created.
-	c.GraphManager = manager.NewGraphManager(c.conn, c.logger, c.executeRequest)
+	graphManager := manager.NewGraphManager(c.conn, c.logger, c.executeRequest)
+	graphManager.SetStrictValidation(c.strict)
+	graphManager.SetDialect(c.dialect)
+	graphManager.SetSchemaValidation(c.schemaValidation)
+
+	c.GraphManager = graphManager
 
 	return c, nil
 }
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/dialect"
 )
 
 // ClientConfiguration is the type used for configuring
@@ -47,6 +48,38 @@ func WithLogger(newLogger logging.Logger) ClientConfiguration {
 	}
 }
 
+// WithStrictValidation will make the client validate every traversal
+// against TinkerPop's grammar before it is sent to the server, so
+// mistakes are returned as errors instead of server-side script
+// evaluation errors. Scripts the client can't parse are logged at
+// the debug level and left for the server to check.
+func WithStrictValidation() ClientConfiguration {
+	return func(c *Client) {
+		c.strict = true
+	}
+}
+
+// WithSchemaValidation will make the client check property values
+// against the data types and cardinalities of their property keys
+// before vertices and properties are added, so mismatches are
+// returned as errors instead of server-side script evaluation errors.
+// The property keys are read from the schema when first needed.
+func WithSchemaValidation() ClientConfiguration {
+	return func(c *Client) {
+		c.schemaValidation = true
+	}
+}
+
+// WithDialect sets the Gremlin provider the client is talking to.
+// Traversals are adapted to the provider, such as using string IDs
+// on Neptune, and steps it doesn't support are rejected with an
+// error before the request goes out.
+func WithDialect(d dialect.Dialect) ClientConfiguration {
+	return func(c *Client) {
+		c.dialect = d
+	}
+}
+
 // WithGremlinVersion sets the version of the gremlin traversal
 // language being used by the client.
 func WithGremlinVersion(versionNumber int) ClientConfiguration {
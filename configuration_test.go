@@ -26,6 +26,8 @@ import (
 	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/dialect"
 )
 
 func TestWithErrorChannel(t *testing.T) {
@@ -57,6 +59,48 @@ func TestWithLogger(t *testing.T) {
 	})
 }
 
+func TestWithStrictValidation(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a dialer", t, func() {
+		dialer := &mockDialerStruct{}
+		Convey("When Dial is called with strict validation", func() {
+			c, _ := mockDial(dialer, WithStrictValidation())
+			Convey("Then the client should be strict", func() {
+				So(c.strict, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestWithSchemaValidation(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a dialer", t, func() {
+		dialer := &mockDialerStruct{}
+		Convey("When Dial is called with schema validation", func() {
+			c, _ := mockDial(dialer, WithSchemaValidation())
+			Convey("Then the client should validate properties", func() {
+				So(c.schemaValidation, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestWithDialect(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a dialect and dialer", t, func() {
+		dialer := &mockDialerStruct{}
+		Convey("When Dial is called with the dialect", func() {
+			c, _ := mockDial(dialer, WithDialect(dialect.Neptune))
+			Convey("Then the client dialect should be set", func() {
+				So(c.dialect, ShouldEqual, dialect.Neptune)
+			})
+		})
+	})
+}
+
 func TestWithGremlinVersion(t *testing.T) {
 	t.Parallel()
 
@@ -63,6 +63,30 @@ var (
 	// querying was empty. This is used on rare occasions when
 	// the Unmarshal process is successful, but returns something empty.
 	ErrEmptyResponse = errors.New("empty response received")
+	// ErrNoIndexKeys is used when an index is
+	// built without any property keys.
+	ErrNoIndexKeys = errors.New("index has no keys")
+	// ErrSchemaConflict is used when a schema definition
+	// doesn't match a schema type that already exists.
+	ErrSchemaConflict = errors.New("schema conflicts with the graph")
+	// ErrMigrationChanged is used when a migration has
+	// been changed after it was applied to the graph.
+	ErrMigrationChanged = errors.New("applied migration has changed")
+	// ErrDuplicateMigration is used when two
+	// migrations are given the same version.
+	ErrDuplicateMigration = errors.New("duplicate migration version")
+	// ErrBulkFailures is used when a bulk operation
+	// finishes but some of its elements failed.
+	ErrBulkFailures = errors.New("elements of the bulk operation failed")
+	// ErrDuplicateKey is used when two elements of
+	// a bulk load are given the same external key.
+	ErrDuplicateKey = errors.New("duplicate external key")
+	// ErrUnknownKey is used when an edge of a bulk load refers
+	// to an external key that no added vertex was given.
+	ErrUnknownKey = errors.New("unknown external key")
+	// ErrNotDropped is used when a batched drop finds
+	// the same elements again after dropping them.
+	ErrNotDropped = errors.New("elements were not dropped")
 )
 
 // GrammesError is a generic error
@@ -43,3 +43,8 @@ func (g *NetworkError) Error() string {
 		fmtError("error", g.msg),
 	)
 }
+
+// StatusCode returns the status code the server responded with.
+func (g *NetworkError) StatusCode() int {
+	return g.statusCode
+}
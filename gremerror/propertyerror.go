@@ -0,0 +1,49 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gremerror
+
+import "fmt"
+
+// PropertyError is used when a property value doesn't fit
+// the data type or cardinality of its key in the schema.
+type PropertyError struct {
+	key    string
+	value  interface{}
+	reason string
+}
+
+// NewPropertyError returns a new PropertyError for
+// the value given to the property key.
+func NewPropertyError(key string, value interface{}, reason string) error {
+	return &PropertyError{
+		key:    key,
+		value:  value,
+		reason: reason,
+	}
+}
+
+func (p *PropertyError) Error() string {
+	return fmtComma(
+		fmtError("type", "PROPERTY_ERROR"),
+		fmtError("key", p.key),
+		fmtError("value", fmt.Sprint(p.value)),
+		fmtError("error", p.reason),
+	)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gremerror
+
+import "strconv"
+
+// ValidationError is used when a traversal is rejected
+// on the client side before being sent to the server.
+type ValidationError struct {
+	step   string
+	index  int
+	reason string
+}
+
+// NewValidationError returns a new ValidationError for the
+// step found at the given index of the traversal.
+func NewValidationError(step string, index int, reason string) error {
+	return &ValidationError{
+		step:   step,
+		index:  index,
+		reason: reason,
+	}
+}
+
+func (v *ValidationError) Error() string {
+	return fmtComma(
+		fmtError("type", "VALIDATION_ERROR"),
+		fmtError("step", v.step),
+		fmtError("index", strconv.Itoa(v.index)),
+		fmtError("error", v.reason),
+	)
+}
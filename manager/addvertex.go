@@ -21,24 +21,33 @@
 package manager
 
 import (
+	"sort"
 	"strconv"
 
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
 	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/merge"
+	"github.com/northwesternmutual/grammes/query/token"
 	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
 type addVertexQueryManager struct {
 	logger             logging.Logger
 	executeStringQuery stringExecutor
+	// executeBoundStringQuery is used for the
+	// queries that send their strings as bindings.
+	executeBoundStringQuery executor
+	version                 *serverVersion
+	schema                  *propertySchema
 }
 
 func newAddVertexQueryManager(logger logging.Logger, executeString stringExecutor) *addVertexQueryManager {
 	return &addVertexQueryManager{
 		logger:             logger,
 		executeStringQuery: executeString,
+		version:            newServerVersion(executeString),
 	}
 }
 
@@ -46,9 +55,16 @@ func newAddVertexQueryManager(logger logging.Logger, executeString stringExecuto
 // API object if you want to create it in a struct format rather than a command.
 func (v *addVertexQueryManager) AddAPIVertex(data model.APIData) (model.Vertex, error) {
 	query := traversal.NewTraversal().AddV(data.Label)
+	properties := make([]interface{}, 0, len(data.Properties)*2)
 	// Add properties to the vertex based on the API.
-	for k, v := range data.Properties {
-		query.AddStep("property", k, v)
+	for k, val := range data.Properties {
+		query.AddStep("property", k, val)
+		properties = append(properties, k, val)
+	}
+
+	if err := v.schema.validate(properties...); err != nil {
+		v.logger.Error("AddAPIVertex: invalid property", err)
+		return nilVertex, err
 	}
 
 	addedVertex, err := v.AddVertexByString(query.String())
@@ -98,6 +114,11 @@ func (v *addVertexQueryManager) AddVertex(label string, properties ...interface{
 		return nilVertex, gremerror.ErrOddNumberOfParameters
 	}
 
+	if err := v.schema.validate(properties...); err != nil {
+		v.logger.Error("AddVertex: invalid property", err)
+		return nilVertex, err
+	}
+
 	// Begin the command with signature and label.
 	query := traversal.NewTraversal().AddV(label)
 
@@ -108,6 +129,101 @@ func (v *addVertexQueryManager) AddVertex(label string, properties ...interface{
 	return v.AddVertexByString(query.String())
 }
 
+// MergeVertex will find the vertex with the given label and
+// matching properties or create it when there isn't one, then
+// return it. The onCreate properties are only set on a new vertex
+// and the onMatch properties are only set on an existing one.
+// Servers running TinkerPop 3.6 or newer are sent a mergeV() step
+// while older servers are sent a fold().coalesce() traversal.
+// Like the upserts, its strings are sent as bindings.
+func (v *addVertexQueryManager) MergeVertex(label string, match, onCreate, onMatch map[string]interface{}) (model.Vertex, error) {
+	for _, props := range []map[string]interface{}{match, onCreate, onMatch} {
+		if err := v.schema.validate(propertyList(props)...); err != nil {
+			v.logger.Error("MergeVertex: invalid property", err)
+			return nilVertex, err
+		}
+	}
+
+	var (
+		b     = newBindings()
+		query traversal.String
+	)
+
+	if v.version.atLeast(3, 6) {
+		query = mergeVertexQuery(b, label, match, onCreate, onMatch)
+	} else {
+		query = coalesceVertexQuery(b, label, match, onCreate, onMatch)
+	}
+
+	responses, err := v.executeBoundStringQuery(query.String(), b.values, map[string]string{})
+	if err != nil {
+		v.logger.Error("MergeVertex: invalid query merging vertex",
+			gremerror.NewQueryError("MergeVertex", query.String(), err),
+		)
+		return nilVertex, err
+	}
+
+	return v.firstVertex("MergeVertex", responses)
+}
+
+// mergeVertexQuery renders a vertex upsert with the mergeV() step.
+func mergeVertexQuery(b *bindings, label string, match, onCreate, onMatch map[string]interface{}) traversal.String {
+	boundLabel := b.bind(label)
+
+	query := traversal.NewTraversal().MergeV(bindMap(b, token.Label, boundLabel, match))
+
+	if len(onCreate) > 0 {
+		create := make(map[string]interface{}, len(match)+len(onCreate))
+		for k, val := range match {
+			create[k] = val
+		}
+		for k, val := range onCreate {
+			create[k] = val
+		}
+		query = query.MergeOption(merge.OnCreate, bindMap(b, token.Label, boundLabel, create))
+	}
+
+	if len(onMatch) > 0 {
+		query = query.MergeOption(merge.OnMatch, bindMap(b, nil, nil, onMatch))
+	}
+
+	return query
+}
+
+// coalesceVertexQuery renders a vertex upsert for servers
+// older than TinkerPop 3.6 that don't have the mergeV() step.
+func coalesceVertexQuery(b *bindings, label string, match, onCreate, onMatch map[string]interface{}) traversal.String {
+	boundLabel := b.bind(label)
+
+	query := traversal.NewTraversal().V()
+	query.AddStep("hasLabel", boundLabel)
+	for _, k := range sortedKeys(match) {
+		query.AddStep("has", b.bind(k), b.bind(match[k]))
+	}
+
+	found := traversal.NewTraversal().Unfold()
+	setProperties(b, &found, onMatch)
+
+	created := traversal.NewTraversal()
+	created.AddStep("addV", boundLabel)
+	setProperties(b, &created, match)
+	setProperties(b, &created, onCreate)
+
+	return query.Fold().Coalesce(found.Raw(), created.Raw())
+}
+
+// sortedKeys returns the keys of the properties in
+// order so the same upsert always renders the same way.
+func sortedKeys(props map[string]interface{}) []string {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
 // AddVertexLabels will do the same as AddVertexLabel, but with
 // the ability to add multiple labels at a time.
 func (v *addVertexQueryManager) AddVertexLabels(labels ...string) ([]model.Vertex, error) {
@@ -142,15 +258,21 @@ func (v *addVertexQueryManager) AddVertexByString(query string) (model.Vertex, e
 		return nilVertex, err
 	}
 
+	return v.firstVertex("AddVertexByString", responses)
+}
+
+// firstVertex unmarshals the responses of the
+// caller's query and returns the first vertex.
+func (v *addVertexQueryManager) firstVertex(caller string, responses [][]byte) (model.Vertex, error) {
 	var list model.VertexList
 
 	for _, res := range responses {
 		var vertPart model.VertexList
 		// Create the resulting vertices from the query.
-		err = jsonUnmarshal(res, &vertPart)
+		err := jsonUnmarshal(res, &vertPart)
 		if err != nil {
 			v.logger.Error("vertices unmarshal",
-				gremerror.NewUnmarshalError("AddVertexByString", res, err),
+				gremerror.NewUnmarshalError(caller, res, err),
 			)
 			return nilVertex, err
 		}
@@ -27,8 +27,11 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
 )
 
 var testVertex = model.Vertex{
@@ -208,3 +211,108 @@ func TestAddVertexByStringReturnnilVertex(t *testing.T) {
 		})
 	})
 }
+
+func TestMergeVertex(t *testing.T) {
+	Convey("Given a vertex query manager", t, func() {
+		var (
+			lookups []string
+			queries []boundQuery
+			version = `["3.6.2"]`
+		)
+		execute := func(q string) ([][]byte, error) {
+			lookups = append(lookups, q)
+			return [][]byte{[]byte(version)}, nil
+		}
+		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
+		qm.executeBoundStringQuery = mockBoundExecutor(&queries, vertexResponse, nil)
+		match := map[string]interface{}{"name": "damien"}
+		onCreate := map[string]interface{}{"age": 24}
+		onMatch := map[string]interface{}{"seen": true}
+		Convey("When MergeVertex is called on a TinkerPop 3.6 server", func() {
+			v, err := qm.MergeVertex("person", match, onCreate, onMatch)
+			Convey("Then the mergeV step should be used with its strings bound", func() {
+				So(err, ShouldBeNil)
+				So(v.Value.ID.Value, ShouldEqual, 28720)
+				So(queries, ShouldResemble, []boundQuery{{
+					"g.mergeV([(T.label):b0,(b1):b2])" +
+						".option(Merge.onCreate,[(T.label):b0,(b3):24,(b1):b2])" +
+						".option(Merge.onMatch,[(b4):true])",
+					map[string]string{"b0": "person", "b1": "name", "b2": "damien", "b3": "age", "b4": "seen"},
+				}})
+			})
+		})
+
+		Convey("When MergeVertex is called with strings Groovy would interpolate", func() {
+			qm.MergeVertex("person", map[string]interface{}{"name": `${"x"}`}, nil, nil)
+			Convey("Then they should only be sent as bindings", func() {
+				So(queries[0].query, ShouldEqual, "g.mergeV([(T.label):b0,(b1):b2])")
+				So(queries[0].bindings["b2"], ShouldEqual, `${"x"}`)
+			})
+		})
+
+		Convey("When MergeVertex is called twice", func() {
+			qm.MergeVertex("person", match, nil, nil)
+			qm.MergeVertex("person", match, nil, nil)
+			Convey("Then the version should only be looked up once", func() {
+				So(lookups, ShouldResemble, []string{"Gremlin.version()"})
+				So(queries, ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When MergeVertex is called on a TinkerPop 3.5 server", func() {
+			version = `{"@type":"g:List","@value":["3.5.4"]}`
+			qm.MergeVertex("person", match, onCreate, onMatch)
+			Convey("Then the coalesce pattern should be used with its strings bound", func() {
+				So(queries[0].query, ShouldEqual, "g.V().hasLabel(b0).has(b1,b2).fold()"+
+					".coalesce(unfold().property(b3,true),"+
+					"addV(b0).property(b1,b2).property(b4,24))")
+				So(queries[0].bindings, ShouldResemble, map[string]string{
+					"b0": "person", "b1": "name", "b2": "damien", "b3": "seen", "b4": "age",
+				})
+			})
+		})
+
+		Convey("When MergeVertex is called on a server that won't report its version", func() {
+			version = `"unknown"`
+			qm.MergeVertex("person", match, nil, nil)
+			Convey("Then the coalesce pattern should be used", func() {
+				So(queries[0].query, ShouldEqual, "g.V().hasLabel(b0).has(b1,b2).fold()"+
+					".coalesce(unfold(),addV(b0).property(b1,b2))")
+			})
+		})
+	})
+}
+
+func TestMergeVertexSchemaValidation(t *testing.T) {
+	Convey("Given a vertex query manager with schema validation", t, func() {
+		var queries []boundQuery
+		qm := newAddVertexQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.6.2"))
+		qm.executeBoundStringQuery = mockBoundExecutor(&queries, vertexResponse, nil)
+		qm.schema = newPropertySchema(func() ([]model.PropertyKey, error) {
+			return []model.PropertyKey{{Name: "age", DataType: datatype.Integer, Cardinality: cardinality.Single}}, nil
+		})
+		qm.schema.setEnabled(true)
+		Convey("When MergeVertex is called with an invalid onCreate property", func() {
+			_, err := qm.MergeVertex("person", nil, map[string]interface{}{"age": "thirty"}, nil)
+			Convey("Then a property error should be returned without a query", func() {
+				So(err, ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+				So(queries, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestMergeVertexQueryError(t *testing.T) {
+	Convey("Given a vertex query manager", t, func() {
+		var queries []boundQuery
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(`["3.6.2"]`)}, nil }
+		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
+		qm.executeBoundStringQuery = mockBoundExecutor(&queries, "", errors.New("ERROR"))
+		Convey("When MergeVertex is called and encounters a querying error", func() {
+			_, err := qm.MergeVertex("person", map[string]interface{}{"name": "damien"}, nil, nil)
+			Convey("Then err should not be nil", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
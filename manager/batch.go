@@ -0,0 +1,210 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"sync"
+
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+const (
+	defaultBatchSize = 100
+	// defaultMaxScriptSize leaves room for the rest of the request
+	// below Gremlin Server's default limit of 65536 bytes.
+	defaultMaxScriptSize = 60000
+	defaultConcurrency   = 4
+	// batchOverhead is the size of the union() around the traversals.
+	batchOverhead = len("g.inject(0).union()")
+)
+
+// BatchOption configures how a bulk operation
+// splits its work into batches and runs them.
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	size          int
+	maxScriptSize int
+	concurrency   int
+	progress      func(model.BulkProgress)
+	edgesFirst    bool
+}
+
+func newBatchConfig(options ...BatchOption) batchConfig {
+	c := batchConfig{
+		size:          defaultBatchSize,
+		maxScriptSize: defaultMaxScriptSize,
+		concurrency:   defaultConcurrency,
+	}
+
+	for _, option := range options {
+		option(&c)
+	}
+
+	return c
+}
+
+// WithBatchSize sets the most elements that are sent
+// in a single batch. The default is 100 elements.
+func WithBatchSize(n int) BatchOption {
+	return func(c *batchConfig) {
+		if n > 0 {
+			c.size = n
+		}
+	}
+}
+
+// WithMaxScriptSize sets about how many bytes the script and
+// bindings of a batch may take. An element that is larger on
+// its own is sent alone. The default is 60000 bytes.
+func WithMaxScriptSize(bytes int) BatchOption {
+	return func(c *batchConfig) {
+		if bytes > 0 {
+			c.maxScriptSize = bytes
+		}
+	}
+}
+
+// WithConcurrency sets how many batches are sent
+// at the same time. The default is 4 batches.
+func WithConcurrency(n int) BatchOption {
+	return func(c *batchConfig) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+// WithProgress sets a function that is given the progress of the
+// operation after each batch. It is never called concurrently.
+func WithProgress(report func(model.BulkProgress)) BatchOption {
+	return func(c *batchConfig) {
+		c.progress = report
+	}
+}
+
+// WithEdgesFirst makes a batched drop remove the edges of the
+// vertices before the vertices themselves, so each batch of
+// vertices doesn't also have to remove their edges.
+func WithEdgesFirst() BatchOption {
+	return func(c *batchConfig) {
+		c.edgesFirst = true
+	}
+}
+
+// batch is a group of elements rendered into a single traversal.
+type batch struct {
+	indexes  []int
+	query    traversal.String
+	bindings map[string]string
+}
+
+// renderer renders the element at the index with the bindings.
+type renderer func(b *bindings, i int) traversal.String
+
+// singleBatch renders the element at the index as a batch of its own.
+func singleBatch(render renderer, i int) batch {
+	b := newBindings()
+	return batch{indexes: []int{i}, query: render(b, i), bindings: b.values}
+}
+
+// pack renders the elements at the indexes into batches that are no
+// larger than the configuration allows and sends them on the returned
+// channel, which is closed after the last batch. The batches are
+// rendered as they're needed so a large load isn't held in memory.
+func (c batchConfig) pack(indexes []int, render renderer) <-chan batch {
+	batches := make(chan batch, c.concurrency)
+
+	go func() {
+		defer close(batches)
+
+		var (
+			b          = newBindings()
+			traversals []traversal.String
+			current    []int
+			size       int
+		)
+
+		for _, i := range indexes {
+			mark := len(b.values)
+			t := render(b, i)
+
+			if len(current) > 0 && (len(current) >= c.size || batchOverhead+size+len(t.String())+b.size > c.maxScriptSize) {
+				b.rollback(mark)
+				batches <- batch{indexes: current, query: batchQuery(traversals), bindings: b.values}
+
+				b = newBindings()
+				traversals, current, size = nil, nil, 0
+				t = render(b, i)
+			}
+
+			traversals = append(traversals, t)
+			current = append(current, i)
+			size += len(t.String()) + 1
+		}
+
+		if len(current) > 0 {
+			batches <- batch{indexes: current, query: batchQuery(traversals), bindings: b.values}
+		}
+	}()
+
+	return batches
+}
+
+// run calls do for every batch with as many batches
+// running at the same time as the configuration allows.
+func (c batchConfig) run(batches <-chan batch, do func(batch)) {
+	var wg sync.WaitGroup
+
+	for w := 0; w < c.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for bt := range batches {
+				do(bt)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// progressTracker adds up the progress of the batches and
+// reports it without calling the progress function concurrently.
+type progressTracker struct {
+	mu       sync.Mutex
+	progress model.BulkProgress
+	report   func(model.BulkProgress)
+}
+
+// add counts a finished batch and reports the progress.
+func (p *progressTracker) add(done, failed int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.progress.Batches++
+	p.progress.Done += done
+	p.progress.Failed += failed
+
+	if p.report != nil {
+		p.report(p.progress)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func TestNewBatchConfig(t *testing.T) {
+	Convey("Given no batch options", t, func() {
+		c := newBatchConfig()
+		Convey("Then the defaults should be used", func() {
+			So(c.size, ShouldEqual, defaultBatchSize)
+			So(c.maxScriptSize, ShouldEqual, defaultMaxScriptSize)
+			So(c.concurrency, ShouldEqual, defaultConcurrency)
+			So(c.progress, ShouldBeNil)
+		})
+	})
+
+	Convey("Given batch options", t, func() {
+		var reported bool
+		c := newBatchConfig(WithBatchSize(10), WithMaxScriptSize(1000), WithConcurrency(2),
+			WithProgress(func(model.BulkProgress) { reported = true }))
+		Convey("Then they should be used", func() {
+			So(c.size, ShouldEqual, 10)
+			So(c.maxScriptSize, ShouldEqual, 1000)
+			So(c.concurrency, ShouldEqual, 2)
+			c.progress(model.BulkProgress{})
+			So(reported, ShouldBeTrue)
+		})
+	})
+
+	Convey("Given batch options that aren't positive", t, func() {
+		c := newBatchConfig(WithBatchSize(0), WithMaxScriptSize(-1), WithConcurrency(0))
+		Convey("Then the defaults should be kept", func() {
+			So(c.size, ShouldEqual, defaultBatchSize)
+			So(c.maxScriptSize, ShouldEqual, defaultMaxScriptSize)
+			So(c.concurrency, ShouldEqual, defaultConcurrency)
+		})
+	})
+}
+
+func TestBatchConfigPack(t *testing.T) {
+	render := func(b *bindings, i int) traversal.String {
+		query := traversal.NewTraversal()
+		query.AddStep("addV", b.bind("person"))
+		query.AddStep("property", b.bind("name"), b.bind("name"+strconv.Itoa(i)))
+		return query
+	}
+
+	collect := func(batches <-chan batch) []batch {
+		var all []batch
+		for bt := range batches {
+			all = append(all, bt)
+		}
+		return all
+	}
+
+	Convey("Given a batch size", t, func() {
+		c := newBatchConfig(WithBatchSize(2))
+		Convey("When the elements are packed", func() {
+			batches := collect(c.pack([]int{0, 1, 2}, render))
+			Convey("Then no batch should have more elements", func() {
+				So(batches, ShouldHaveLength, 2)
+				So(batches[0].indexes, ShouldResemble, []int{0, 1})
+				So(batches[0].query.String(), ShouldEqual, "g.inject(0).union(addV(b0).property(b1,b2),addV(b0).property(b1,b3))")
+				So(batches[0].bindings, ShouldResemble, map[string]string{"b0": "person", "b1": "name", "b2": "name0", "b3": "name1"})
+				So(batches[1].indexes, ShouldResemble, []int{2})
+				So(batches[1].query.String(), ShouldEqual, "g.addV(b0).property(b1,b2)")
+				So(batches[1].bindings, ShouldResemble, map[string]string{"b0": "person", "b1": "name", "b2": "name2"})
+			})
+		})
+	})
+
+	Convey("Given a max script size that fits one element", t, func() {
+		c := newBatchConfig(WithMaxScriptSize(80))
+		Convey("When the elements are packed", func() {
+			batches := collect(c.pack([]int{0, 1}, render))
+			Convey("Then each element should be in a batch of its own", func() {
+				So(batches, ShouldHaveLength, 2)
+				So(batches[1].indexes, ShouldResemble, []int{1})
+				So(batches[1].bindings, ShouldResemble, map[string]string{"b0": "person", "b1": "name", "b2": "name1"})
+			})
+		})
+	})
+
+	Convey("Given a max script size smaller than an element", t, func() {
+		c := newBatchConfig(WithMaxScriptSize(1))
+		Convey("When an element is packed", func() {
+			batches := collect(c.pack([]int{0}, render))
+			Convey("Then it should be sent alone anyway", func() {
+				So(batches, ShouldHaveLength, 1)
+				So(batches[0].indexes, ShouldResemble, []int{0})
+			})
+		})
+	})
+}
+
+func TestProgressTracker(t *testing.T) {
+	Convey("Given a progress tracker", t, func() {
+		var reports []model.BulkProgress
+		p := &progressTracker{report: func(progress model.BulkProgress) { reports = append(reports, progress) }}
+		p.progress.Total = 5
+		Convey("When batches are added", func() {
+			p.add(2, 0)
+			p.add(1, 2)
+			Convey("Then the progress should be added up and reported", func() {
+				So(reports, ShouldResemble, []model.BulkProgress{
+					{Batches: 1, Done: 2, Total: 5},
+					{Batches: 2, Done: 3, Failed: 2, Total: 5},
+				})
+			})
+		})
+	})
+}
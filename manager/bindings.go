@@ -0,0 +1,89 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"strconv"
+
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// bindings collects the strings of a script as parameter bindings
+// so they're sent to the server apart from the script rather than
+// being rendered into it, where quotes could break the script.
+type bindings struct {
+	values map[string]string
+	// names are the bindings by their strings so
+	// a string repeated in the script is bound once.
+	names map[string]string
+	// size is about how many bytes the
+	// bindings add to the request.
+	size int
+}
+
+func newBindings() *bindings {
+	return &bindings{
+		values: make(map[string]string),
+		names:  make(map[string]string),
+	}
+}
+
+// bind returns the value to render in the traversal. A string is
+// replaced by the name of the binding holding it, and any other
+// value, such as a number, is rendered as it is. Since bindings are
+// sent as strings, only strings are bound.
+func (b *bindings) bind(value interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	name, ok := b.names[s]
+	if !ok {
+		name = bindingName(len(b.values))
+		b.values[name] = s
+		b.names[s] = name
+		b.size += bindingSize(name, s)
+	}
+
+	return traversal.NewCustomTraversal(name)
+}
+
+// rollback removes the bindings made after the first n so
+// a traversal that was rendered with them can be dropped.
+func (b *bindings) rollback(n int) {
+	for i := len(b.values) - 1; i >= n; i-- {
+		name := bindingName(i)
+		s := b.values[name]
+		delete(b.values, name)
+		delete(b.names, s)
+		b.size -= bindingSize(name, s)
+	}
+}
+
+func bindingName(i int) string {
+	return "b" + strconv.Itoa(i)
+}
+
+// bindingSize is the size of the binding as a quoted JSON
+// key and value with their separators, ignoring escapes.
+func bindingSize(name, value string) int {
+	return len(name) + len(value) + 6
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func TestBindings(t *testing.T) {
+	Convey("Given bindings", t, func() {
+		b := newBindings()
+		Convey("When strings are bound", func() {
+			first := b.bind(`say "hi"`)
+			second := b.bind("name")
+			repeated := b.bind(`say "hi"`)
+			Convey("Then each string should be bound once by name", func() {
+				So(first, ShouldResemble, traversal.NewCustomTraversal("b0"))
+				So(second, ShouldResemble, traversal.NewCustomTraversal("b1"))
+				So(repeated, ShouldResemble, first)
+				So(b.values, ShouldResemble, map[string]string{"b0": `say "hi"`, "b1": "name"})
+			})
+		})
+		Convey("When other values are bound", func() {
+			Convey("Then they should be returned as they are", func() {
+				So(b.bind(3), ShouldEqual, 3)
+				So(b.bind(true), ShouldEqual, true)
+				So(b.values, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// Each element of a bulk load is rendered as a branch of a
+// union() that projects the element's index in the load, and
+// the ID of a vertex, so the results can be told apart.
+const (
+	bulkIndex = "index"
+	bulkID    = "id"
+)
+
+type bulkQueryManager struct {
+	logger                  logging.Logger
+	executeBoundStringQuery executor
+	schema                  *propertySchema
+}
+
+func newBulkQueryManager(logger logging.Logger, executor executor) *bulkQueryManager {
+	return &bulkQueryManager{
+		logger:                  logger,
+		executeBoundStringQuery: executor,
+	}
+}
+
+// bulkAdded is an element that was added by a batch.
+type bulkAdded struct {
+	Index int   `json:"index"`
+	ID    int64 `json:"id"`
+}
+
+// bulkLoad is the state of a single bulk load.
+type bulkLoad struct {
+	m        *bulkQueryManager
+	config   batchConfig
+	vertices []model.BulkVertex
+	edges    []model.BulkEdge
+	// ends are the IDs of the vertices of each edge.
+	ends     [][2]int64
+	progress *progressTracker
+
+	mu     sync.Mutex
+	result model.BulkResult
+}
+
+// BulkLoad adds the vertices and then the edges between them in
+// batches. Each batch is a single traversal with its strings sent
+// as bindings, and batches are sent concurrently. Edges refer to the
+// vertices of the load by their external keys, which are mapped to
+// the IDs of the added vertices in the result.
+//
+// When the server rejects a batch's script its elements are tried
+// one at a time so the result records each element that failed, and
+// ErrBulkFailures is returned with the result when any element failed.
+// Any other error, such as a timeout or a lost connection, fails every
+// element of the batch without retrying it, since the batch may have
+// been committed anyway. Loads are not idempotent: loading the failed
+// elements again may duplicate the ones that were added.
+func (m *bulkQueryManager) BulkLoad(vertices []model.BulkVertex, edges []model.BulkEdge, options ...BatchOption) (model.BulkResult, error) {
+	config := newBatchConfig(options...)
+
+	l := &bulkLoad{
+		m:        m,
+		config:   config,
+		vertices: vertices,
+		edges:    edges,
+		ends:     make([][2]int64, len(edges)),
+		progress: &progressTracker{report: config.progress},
+		result:   model.BulkResult{IDs: make(map[string]int64)},
+	}
+	l.progress.progress.Total = int64(len(vertices) + len(edges))
+
+	l.run(l.pendingVertices(), l.renderVertex, func(a bulkAdded) {
+		if key := l.vertices[a.Index].Key; key != "" {
+			l.result.IDs[key] = a.ID
+		}
+	}, l.failVertex)
+
+	l.run(l.pendingEdges(), l.renderEdge, func(bulkAdded) {}, l.failEdge)
+
+	if len(l.result.VertexFailures) > 0 || len(l.result.EdgeFailures) > 0 {
+		return l.result, gremerror.ErrBulkFailures
+	}
+
+	return l.result, nil
+}
+
+// pendingVertices returns the indexes of the vertices to add
+// and records the ones that can't be added as failures.
+func (l *bulkLoad) pendingVertices() []int {
+	var (
+		pending = make([]int, 0, len(l.vertices))
+		keys    = make(map[string]bool, len(l.vertices))
+	)
+
+	for i, v := range l.vertices {
+		if v.Key != "" && keys[v.Key] {
+			l.failVertex(i, gremerror.ErrDuplicateKey)
+			continue
+		}
+		keys[v.Key] = true
+
+		if err := l.m.schema.validate(propertyList(v.Properties)...); err != nil {
+			l.failVertex(i, err)
+			continue
+		}
+
+		pending = append(pending, i)
+	}
+
+	return pending
+}
+
+// pendingEdges returns the indexes of the edges to add
+// and records the ones that can't be added as failures.
+func (l *bulkLoad) pendingEdges() []int {
+	pending := make([]int, 0, len(l.edges))
+
+	for i, e := range l.edges {
+		out, outFound := l.vertexID(e.OutKey, e.OutID)
+		in, inFound := l.vertexID(e.InKey, e.InID)
+		if !outFound || !inFound {
+			l.failEdge(i, gremerror.ErrUnknownKey)
+			continue
+		}
+
+		if err := l.m.schema.validate(propertyList(e.Properties)...); err != nil {
+			l.failEdge(i, err)
+			continue
+		}
+
+		l.ends[i] = [2]int64{out, in}
+		pending = append(pending, i)
+	}
+
+	return pending
+}
+
+// vertexID returns the ID of the vertex added with the
+// external key, or the ID when the key is empty.
+func (l *bulkLoad) vertexID(key string, id int64) (int64, bool) {
+	if key == "" {
+		return id, true
+	}
+
+	id, ok := l.result.IDs[key]
+	return id, ok
+}
+
+func (l *bulkLoad) renderVertex(b *bindings, i int) traversal.String {
+	v := l.vertices[i]
+
+	query := traversal.NewTraversal()
+	if v.Label == "" {
+		query.AddStep("addV")
+	} else {
+		query.AddStep("addV", b.bind(v.Label))
+	}
+	setProperties(b, &query, v.Properties)
+
+	return query.Project(bulkIndex, bulkID).
+		By(traversal.NewTraversal().Constant(strconv.Itoa(i)).Raw()).
+		By(traversal.NewTraversal().ID().Raw())
+}
+
+func (l *bulkLoad) renderEdge(b *bindings, i int) traversal.String {
+	e, ends := l.edges[i], l.ends[i]
+
+	query := traversal.NewTraversal().V().HasID(ends[0])
+	query.AddStep("addE", b.bind(e.Label))
+	query = query.To(traversal.NewTraversal().V().HasID(ends[1]).Raw())
+	setProperties(b, &query, e.Properties)
+
+	return query.Project(bulkIndex).By(traversal.NewTraversal().Constant(strconv.Itoa(i)).Raw())
+}
+
+func (l *bulkLoad) failVertex(i int, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.result.VertexFailures = append(l.result.VertexFailures, model.BulkFailure{Index: i, Err: err})
+}
+
+func (l *bulkLoad) failEdge(i int, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.result.EdgeFailures = append(l.result.EdgeFailures, model.BulkFailure{Index: i, Err: err})
+}
+
+// run sends the elements at the indexes in batches
+// and records which were added and which failed.
+func (l *bulkLoad) run(indexes []int, render renderer, added func(bulkAdded), failed func(int, error)) {
+	// Failures found before sending anything are reported with the first batch.
+	l.progress.progress.Failed = int64(len(l.result.VertexFailures) + len(l.result.EdgeFailures))
+
+	record := func(a bulkAdded) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		added(a)
+	}
+
+	l.config.run(l.config.pack(indexes, render), func(bt batch) {
+		done, fails := l.execute(bt, render, record, failed)
+		l.progress.add(done, fails)
+	})
+}
+
+// execute sends the batch, falling back to sending its elements
+// one at a time when the server rejects the batch's script.
+func (l *bulkLoad) execute(bt batch, render renderer, added func(bulkAdded), failed func(int, error)) (done, fails int64) {
+	results, err := l.m.executeBatch(bt)
+	if err != nil {
+		if len(bt.indexes) == 1 || !rejected(err) {
+			for _, i := range bt.indexes {
+				failed(i, err)
+			}
+			return 0, int64(len(bt.indexes))
+		}
+
+		for _, i := range bt.indexes {
+			d, f := l.execute(singleBatch(render, i), render, added, failed)
+			done, fails = done+d, fails+f
+		}
+
+		return done, fails
+	}
+
+	inBatch := make(map[int]bool, len(bt.indexes))
+	for _, i := range bt.indexes {
+		inBatch[i] = true
+	}
+
+	for _, r := range results {
+		if inBatch[r.Index] {
+			delete(inBatch, r.Index)
+			added(r)
+			done++
+		}
+	}
+
+	// An element without a result wasn't added, such as
+	// an edge from a vertex that isn't in the graph.
+	for _, i := range bt.indexes {
+		if inBatch[i] {
+			failed(i, gremerror.ErrEmptyResponse)
+			fails++
+		}
+	}
+
+	return done, fails
+}
+
+// rejected returns whether the server refused or failed to evaluate
+// the script, which rolls the batch back so its elements can be retried.
+func rejected(err error) bool {
+	switch t := err.(type) {
+	case *gremerror.ValidationError:
+		return true
+	case *gremerror.NetworkError:
+		switch t.StatusCode() {
+		case 498, 499, 597:
+			return true
+		}
+	}
+
+	return false
+}
+
+// executeBatch sends the batch and returns the elements it added.
+func (m *bulkQueryManager) executeBatch(bt batch) ([]bulkAdded, error) {
+	responses, err := m.executeBoundStringQuery(bt.query.String(), bt.bindings, map[string]string{})
+	if err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("BulkLoad", bt.query.String(), err),
+		)
+		return nil, err
+	}
+
+	var added []bulkAdded
+	if err = model.UnmarshalValues(responses, &added); err != nil {
+		m.logger.Error("bulk unmarshal", err)
+		return nil, err
+	}
+
+	return added, nil
+}
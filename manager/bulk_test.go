@@ -0,0 +1,199 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+var bulkIndexPattern = regexp.MustCompile(`constant\((\d+)\)`)
+
+// mockBulkServer answers bulk batches by adding every element of the
+// batch, giving vertex i the ID 100+i. Batches with a "bad" binding
+// fail to evaluate, batches with a "slow" binding time out, and edges
+// from the vertex with the ID 404 aren't added.
+type mockBulkServer struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+func (s *mockBulkServer) execute(q string, bindings, _ map[string]string) ([][]byte, error) {
+	s.mu.Lock()
+	s.queries = append(s.queries, q)
+	s.mu.Unlock()
+
+	for _, v := range bindings {
+		switch v {
+		case "bad":
+			return nil, gremerror.NewNetworkError(597, "SCRIPT EVALUATION ERROR")
+		case "slow":
+			return nil, gremerror.NewNetworkError(598, "SERVER TIMEOUT")
+		}
+	}
+
+	var (
+		branches = strings.Split(q, "project(")
+		results  []string
+	)
+
+	for _, branch := range branches[1:] {
+		index := bulkIndexPattern.FindStringSubmatch(branch)[1]
+		if strings.Contains(branch, `"id"`) {
+			i, _ := strconv.Atoi(index)
+			results = append(results, fmt.Sprintf(`{"@type":"g:Map","@value":["index",{"@type":"g:Int32","@value":%s},"id",{"@type":"g:Int64","@value":%d}]}`, index, 100+i))
+			continue
+		}
+		results = append(results, fmt.Sprintf(`{"@type":"g:Map","@value":["index",{"@type":"g:Int32","@value":%s}]}`, index))
+	}
+
+	// An edge out of a missing vertex has no result.
+	if strings.Contains(q, "hasId(404)") {
+		results = nil
+	}
+
+	return [][]byte{[]byte(`{"@type":"g:List","@value":[` + strings.Join(results, ",") + `]}`)}, nil
+}
+
+func TestBulkLoad(t *testing.T) {
+	Convey("Given a bulk query manager", t, func() {
+		server := &mockBulkServer{}
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		vertices := []model.BulkVertex{
+			{Key: "a", Label: "person", Properties: map[string]interface{}{"name": "a"}},
+			{Key: "b", Label: "person", Properties: map[string]interface{}{"name": "b"}},
+			{Key: "c", Label: "person", Properties: map[string]interface{}{"name": "c"}},
+			{Label: "person"},
+		}
+		edges := []model.BulkEdge{
+			{OutKey: "a", InKey: "b", Label: "knows"},
+			{OutKey: "b", InID: 7, Label: "knows", Properties: map[string]interface{}{"since": 2010}},
+		}
+		Convey("When BulkLoad is called", func() {
+			var reports []model.BulkProgress
+			result, err := bm.BulkLoad(vertices, edges, WithBatchSize(2), WithConcurrency(2),
+				WithProgress(func(p model.BulkProgress) { reports = append(reports, p) }))
+			Convey("Then the vertices and edges should be added in batches", func() {
+				So(err, ShouldBeNil)
+				So(server.queries, ShouldHaveLength, 3)
+				So(server.queries[2], ShouldEqual, `g.inject(0).union(`+
+					`V().hasId(100).addE(b0).to(V().hasId(101)).project("index").by(constant(0)),`+
+					`V().hasId(101).addE(b0).to(V().hasId(7)).property(b1,2010).project("index").by(constant(1)))`)
+			})
+			Convey("Then the IDs should be mapped by the external keys", func() {
+				So(result.IDs, ShouldResemble, map[string]int64{"a": 100, "b": 101, "c": 102})
+				So(result.VertexFailures, ShouldBeEmpty)
+				So(result.EdgeFailures, ShouldBeEmpty)
+			})
+			Convey("Then the progress should be reported after each batch", func() {
+				So(reports, ShouldHaveLength, 3)
+				So(reports[2], ShouldResemble, model.BulkProgress{Batches: 3, Done: 6, Total: 6})
+			})
+		})
+	})
+
+	Convey("Given a bulk load with an element that fails", t, func() {
+		server := &mockBulkServer{}
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		vertices := []model.BulkVertex{
+			{Key: "a", Label: "person"},
+			{Key: "b", Label: "bad"},
+			{Key: "a", Label: "person"},
+		}
+		edges := []model.BulkEdge{
+			{OutKey: "a", InKey: "b", Label: "knows"},
+			{OutID: 404, InKey: "a", Label: "knows"},
+		}
+		Convey("When BulkLoad is called", func() {
+			var last model.BulkProgress
+			result, err := bm.BulkLoad(vertices, edges, WithProgress(func(p model.BulkProgress) { last = p }))
+			Convey("Then ErrBulkFailures should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrBulkFailures)
+			})
+			Convey("Then the batch should be retried one element at a time", func() {
+				So(server.queries, ShouldHaveLength, 4)
+				So(result.IDs, ShouldResemble, map[string]int64{"a": 100})
+			})
+			Convey("Then each failed element should be recorded", func() {
+				So(result.VertexFailures, ShouldHaveLength, 2)
+				So(result.VertexFailures, ShouldContain, model.BulkFailure{Index: 2, Err: gremerror.ErrDuplicateKey})
+				So(result.EdgeFailures, ShouldResemble, []model.BulkFailure{
+					{Index: 0, Err: gremerror.ErrUnknownKey},
+					{Index: 1, Err: gremerror.ErrEmptyResponse},
+				})
+				So(last, ShouldResemble, model.BulkProgress{Batches: 2, Done: 1, Failed: 4, Total: 5})
+			})
+		})
+	})
+
+	Convey("Given a bulk load with a batch that times out", t, func() {
+		server := &mockBulkServer{}
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		vertices := []model.BulkVertex{
+			{Key: "a", Label: "person"},
+			{Key: "b", Label: "slow"},
+		}
+		Convey("When BulkLoad is called", func() {
+			result, err := bm.BulkLoad(vertices, nil)
+			Convey("Then the batch should not be retried", func() {
+				So(err, ShouldEqual, gremerror.ErrBulkFailures)
+				So(server.queries, ShouldHaveLength, 1)
+			})
+			Convey("Then every element of the batch should fail with the timeout", func() {
+				timeout := gremerror.NewNetworkError(598, "SERVER TIMEOUT")
+				So(result.IDs, ShouldBeEmpty)
+				So(result.VertexFailures, ShouldResemble, []model.BulkFailure{
+					{Index: 0, Err: timeout},
+					{Index: 1, Err: timeout},
+				})
+			})
+		})
+	})
+
+	Convey("Given a bulk query manager with schema validation", t, func() {
+		server := &mockBulkServer{}
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		bm.schema = newPropertySchema(func() ([]model.PropertyKey, error) {
+			return []model.PropertyKey{{Name: "age", DataType: datatype.Integer, Cardinality: cardinality.Single}}, nil
+		})
+		bm.schema.setEnabled(true)
+		Convey("When BulkLoad is called with an invalid property", func() {
+			result, err := bm.BulkLoad([]model.BulkVertex{{Label: "person", Properties: map[string]interface{}{"age": "x"}}}, nil)
+			Convey("Then the vertex should fail without being sent", func() {
+				So(err, ShouldEqual, gremerror.ErrBulkFailures)
+				So(result.VertexFailures, ShouldHaveLength, 1)
+				So(result.VertexFailures[0].Err, ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+				So(server.queries, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// relationID is the key of the ID JanusGraph gives an edge.
+const relationID = "relationId"
+
+// bulkDrop is the state of a single batched drop.
+type bulkDrop struct {
+	m        *bulkQueryManager
+	function string
+	config   batchConfig
+	// bindings are the bindings of the lookups.
+	bindings *bindings
+	progress *progressTracker
+}
+
+func (m *bulkQueryManager) newBulkDrop(function string, options []BatchOption) *bulkDrop {
+	config := newBatchConfig(options...)
+
+	return &bulkDrop{
+		m:        m,
+		function: function,
+		config:   config,
+		bindings: newBindings(),
+		progress: &progressTracker{report: config.progress},
+	}
+}
+
+// DropAllInBatches drops every vertex on the graph, and with them
+// their edges, in batches instead of the single g.V().drop() that can
+// time out on a large graph. The IDs of as many vertices as the
+// concurrent batches hold are looked up and dropped with g.V(ids).drop(),
+// and this repeats until no vertices are left. With WithEdgesFirst
+// the edges are dropped in the same way before the vertices.
+//
+// The progress counts the dropped elements. Its total is zero
+// since counting a large graph takes as long as dropping it.
+func (m *bulkQueryManager) DropAllInBatches(options ...BatchOption) error {
+	d := m.newBulkDrop("DropAllInBatches", options)
+
+	if d.config.edgesFirst {
+		if err := d.drop("E", traversal.NewTraversal().E()); err != nil {
+			return err
+		}
+	}
+
+	return d.drop("V", traversal.NewTraversal().V())
+}
+
+// DropVertexLabelInBatches drops the vertices with the label in
+// batches the same way as DropAllInBatches. With WithEdgesFirst
+// the edges of the vertices are dropped before the vertices.
+func (m *bulkQueryManager) DropVertexLabelInBatches(label string, options ...BatchOption) error {
+	d := m.newBulkDrop("DropVertexLabelInBatches", options)
+
+	vertices := traversal.NewTraversal().V()
+	vertices.AddStep("hasLabel", d.bindings.bind(label))
+
+	if d.config.edgesFirst {
+		// An edge between two of the vertices is found from both ends.
+		if err := d.drop("E", vertices.BothE().Dedup()); err != nil {
+			return err
+		}
+	}
+
+	return d.drop("V", vertices)
+}
+
+// drop looks up and drops the elements in rounds until the lookup
+// finds none. The step is the start step that finds them by ID.
+func (d *bulkDrop) drop(step string, elements traversal.String) error {
+	var (
+		lookup   = elements.Limit(d.config.size * d.config.concurrency).ID()
+		previous string
+	)
+
+	for {
+		ids, err := d.lookup(lookup)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		// Finding the same elements again means they weren't
+		// dropped, and the drop would otherwise never finish.
+		round := fmt.Sprint(ids)
+		if round == previous {
+			d.m.logger.Error("batched drop",
+				gremerror.NewGrammesError(d.function, gremerror.ErrNotDropped),
+			)
+			return gremerror.ErrNotDropped
+		}
+		previous = round
+
+		if err = d.dropRound(step, ids); err != nil {
+			return err
+		}
+	}
+}
+
+// lookup returns the IDs the lookup finds.
+func (d *bulkDrop) lookup(lookup traversal.String) ([]interface{}, error) {
+	responses, err := d.m.executeBoundStringQuery(lookup.String(), d.bindings.values, map[string]string{})
+	if err != nil {
+		d.m.logger.Error("invalid query",
+			gremerror.NewQueryError(d.function, lookup.String(), err),
+		)
+		return nil, err
+	}
+
+	// The IDs are kept raw so long IDs don't lose precision.
+	var raws []json.RawMessage
+	if err = model.UnmarshalValues(responses, &raws); err != nil {
+		d.m.logger.Error("drop unmarshal", err)
+		return nil, err
+	}
+
+	ids := make([]interface{}, 0, len(raws))
+	for _, raw := range raws {
+		id, err := elementID(raw)
+		if err != nil {
+			err = gremerror.NewUnmarshalError(d.function, raw, err)
+			d.m.logger.Error("drop unmarshal", err)
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// elementID returns a numeric ID as a json.Number and any other ID,
+// including the relation ID of a JanusGraph edge, as a string.
+func elementID(raw json.RawMessage) (interface{}, error) {
+	var id interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&id); err != nil {
+		return nil, err
+	}
+
+	switch t := id.(type) {
+	case json.Number, string:
+		return t, nil
+	case map[string]interface{}:
+		if r, ok := t[relationID].(string); ok {
+			return r, nil
+		}
+	}
+
+	return nil, errors.New("unsupported element ID")
+}
+
+// dropRound drops the elements with the IDs in concurrent batches
+// and returns the first error once every batch has finished.
+func (d *bulkDrop) dropRound(step string, ids []interface{}) error {
+	var (
+		batches  = make(chan batch, d.config.concurrency)
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	go func() {
+		defer close(batches)
+
+		for start := 0; start < len(ids); start += d.config.size {
+			end := start + d.config.size
+			if end > len(ids) {
+				end = len(ids)
+			}
+			batches <- renderDrop(step, ids[start:end])
+		}
+	}()
+
+	d.config.run(batches, func(bt batch) {
+		_, err := d.m.executeBoundStringQuery(bt.query.String(), bt.bindings, map[string]string{})
+		if err != nil {
+			d.m.logger.Error("invalid query",
+				gremerror.NewQueryError(d.function, bt.query.String(), err),
+			)
+			d.progress.add(0, int64(len(bt.indexes)))
+
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			mu.Unlock()
+			return
+		}
+
+		d.progress.add(int64(len(bt.indexes)), 0)
+	})
+
+	return firstErr
+}
+
+// renderDrop renders a batch that drops the elements with the IDs,
+// keeping numeric IDs exactly as they were found and binding the rest.
+func renderDrop(step string, ids []interface{}) batch {
+	var (
+		b       = newBindings()
+		params  = make([]interface{}, len(ids))
+		indexes = make([]int, len(ids))
+	)
+
+	for i, id := range ids {
+		if n, ok := id.(json.Number); ok {
+			params[i] = traversal.Number(n)
+		} else {
+			params[i] = b.bind(id)
+		}
+		indexes[i] = i
+	}
+
+	query := traversal.NewTraversal()
+	query.AddStep(step, params...)
+
+	return batch{indexes: indexes, query: query.Drop(), bindings: b.values}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+)
+
+var (
+	dropLimitPattern = regexp.MustCompile(`limit\((\d+)\)`)
+	dropIDsPattern   = regexp.MustCompile(`^g\.[VE]\((.*)\)\.drop\(\)$`)
+)
+
+// mockDropServer holds the IDs of vertices and edges and answers the
+// lookups and drops of a batched drop. Vertices have numeric IDs and
+// edges have JanusGraph relation IDs. A stuck server drops nothing.
+type mockDropServer struct {
+	mu       sync.Mutex
+	vertices []string
+	edges    []string
+	queries  []string
+	bindings []map[string]string
+	stuck    bool
+	failDrop bool
+}
+
+func newMockDropServer(vertices, edges int) *mockDropServer {
+	s := &mockDropServer{}
+	for i := 0; i < vertices; i++ {
+		s.vertices = append(s.vertices, strconv.Itoa(4096+i))
+	}
+	for i := 0; i < edges; i++ {
+		s.edges = append(s.edges, fmt.Sprintf("e%d", i))
+	}
+	return s
+}
+
+func (s *mockDropServer) execute(q string, bindings, _ map[string]string) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.queries = append(s.queries, q)
+	s.bindings = append(s.bindings, bindings)
+
+	if strings.HasSuffix(q, ".id()") {
+		limit, _ := strconv.Atoi(dropLimitPattern.FindStringSubmatch(q)[1])
+
+		var ids []string
+		if strings.Contains(q, "E()") || strings.Contains(q, "bothE()") {
+			for _, e := range s.edges {
+				ids = append(ids, fmt.Sprintf(`{"@type":"janusgraph:RelationIdentifier","@value":{"relationId":"%s"}}`, e))
+			}
+		} else {
+			for _, v := range s.vertices {
+				ids = append(ids, fmt.Sprintf(`{"@type":"g:Int64","@value":%s}`, v))
+			}
+		}
+		if len(ids) > limit {
+			ids = ids[:limit]
+		}
+
+		return [][]byte{[]byte(`{"@type":"g:List","@value":[` + strings.Join(ids, ",") + `]}`)}, nil
+	}
+
+	if s.failDrop {
+		return nil, errors.New("ERROR")
+	}
+
+	if !s.stuck {
+		dropped := make(map[string]bool)
+		for _, id := range strings.Split(dropIDsPattern.FindStringSubmatch(q)[1], ",") {
+			if v, ok := bindings[id]; ok {
+				id = v
+			}
+			dropped[id] = true
+		}
+
+		s.vertices = remaining(s.vertices, dropped)
+		s.edges = remaining(s.edges, dropped)
+	}
+
+	return [][]byte{[]byte(`{"@type":"g:List","@value":[]}`)}, nil
+}
+
+func remaining(ids []string, dropped map[string]bool) []string {
+	var left []string
+	for _, id := range ids {
+		if !dropped[id] {
+			left = append(left, id)
+		}
+	}
+	return left
+}
+
+func TestDropAllInBatches(t *testing.T) {
+	Convey("Given a bulk query manager and a graph with 10 vertices", t, func() {
+		server := newMockDropServer(10, 4)
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		Convey("When DropAllInBatches is called", func() {
+			var reports []model.BulkProgress
+			err := bm.DropAllInBatches(WithBatchSize(3), WithConcurrency(2),
+				WithProgress(func(p model.BulkProgress) { reports = append(reports, p) }))
+			Convey("Then every vertex should be dropped", func() {
+				So(err, ShouldBeNil)
+				So(server.vertices, ShouldBeEmpty)
+			})
+			Convey("Then the vertices should be looked up as many at a time as the batches hold", func() {
+				So(server.queries[0], ShouldEqual, "g.V().limit(6).id()")
+			})
+			Convey("Then the vertices should be dropped by their IDs in batches", func() {
+				So(server.queries, ShouldContain, "g.V(4096,4097,4098).drop()")
+				So(server.queries, ShouldContain, "g.V(4102,4103,4104).drop()")
+				So(server.queries, ShouldContain, "g.V(4105).drop()")
+			})
+			Convey("Then the lookups should repeat until nothing is found", func() {
+				So(server.queries[len(server.queries)-1], ShouldEqual, "g.V().limit(6).id()")
+			})
+			Convey("Then the progress should be reported after each batch", func() {
+				So(reports, ShouldHaveLength, 4)
+				So(reports[3], ShouldResemble, model.BulkProgress{Batches: 4, Done: 10})
+			})
+			Convey("Then the edges shouldn't be dropped on their own", func() {
+				So(server.edges, ShouldHaveLength, 4)
+			})
+		})
+
+		Convey("When DropAllInBatches is called with the edges first", func() {
+			err := bm.DropAllInBatches(WithBatchSize(3), WithConcurrency(1), WithEdgesFirst())
+			Convey("Then the edges should be dropped before the vertices", func() {
+				So(err, ShouldBeNil)
+				So(server.queries[0], ShouldEqual, "g.E().limit(3).id()")
+				So(server.queries[1], ShouldEqual, "g.E(b0,b1,b2).drop()")
+				So(server.bindings[1], ShouldResemble, map[string]string{"b0": "e0", "b1": "e1", "b2": "e2"})
+				So(server.edges, ShouldBeEmpty)
+				So(server.vertices, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a graph with a long vertex ID", t, func() {
+		server := &mockDropServer{vertices: []string{"9007199254740993"}}
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		Convey("When DropAllInBatches is called", func() {
+			err := bm.DropAllInBatches()
+			Convey("Then the ID should be dropped without losing precision", func() {
+				So(err, ShouldBeNil)
+				So(server.queries[1], ShouldEqual, "g.V(9007199254740993).drop()")
+			})
+		})
+	})
+
+	Convey("Given a graph that doesn't drop its vertices", t, func() {
+		server := newMockDropServer(2, 0)
+		server.stuck = true
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		Convey("When DropAllInBatches is called", func() {
+			err := bm.DropAllInBatches()
+			Convey("Then ErrNotDropped should be returned instead of repeating forever", func() {
+				So(err, ShouldEqual, gremerror.ErrNotDropped)
+				So(server.queries, ShouldHaveLength, 3)
+			})
+		})
+	})
+
+	Convey("Given a graph where the drops fail", t, func() {
+		server := newMockDropServer(5, 0)
+		server.failDrop = true
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		Convey("When DropAllInBatches is called", func() {
+			var last model.BulkProgress
+			err := bm.DropAllInBatches(WithBatchSize(2),
+				WithProgress(func(p model.BulkProgress) { last = p }))
+			Convey("Then the error should be returned after the round", func() {
+				So(err, ShouldNotBeNil)
+				So(server.queries, ShouldHaveLength, 4)
+				So(last, ShouldResemble, model.BulkProgress{Batches: 3, Failed: 5})
+			})
+		})
+	})
+
+	Convey("Given a lookup that fails", t, func() {
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		bm := newBulkQueryManager(logging.NewNilLogger(), execute)
+		Convey("When DropAllInBatches is called", func() {
+			err := bm.DropAllInBatches()
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a lookup that returns an unsupported ID", t, func() {
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+			return [][]byte{[]byte(`[true]`)}, nil
+		}
+		bm := newBulkQueryManager(logging.NewNilLogger(), execute)
+		Convey("When DropAllInBatches is called", func() {
+			err := bm.DropAllInBatches()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDropVertexLabelInBatches(t *testing.T) {
+	Convey("Given a bulk query manager", t, func() {
+		server := newMockDropServer(4, 3)
+		bm := newBulkQueryManager(logging.NewNilLogger(), server.execute)
+		Convey("When DropVertexLabelInBatches is called", func() {
+			err := bm.DropVertexLabelInBatches("person", WithBatchSize(2), WithConcurrency(1))
+			Convey("Then the vertices with the label should be dropped", func() {
+				So(err, ShouldBeNil)
+				So(server.queries[0], ShouldEqual, "g.V().hasLabel(b0).limit(2).id()")
+				So(server.bindings[0], ShouldResemble, map[string]string{"b0": "person"})
+				So(server.queries[1], ShouldEqual, "g.V(4096,4097).drop()")
+				So(server.vertices, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When DropVertexLabelInBatches is called with the edges first", func() {
+			err := bm.DropVertexLabelInBatches("person", WithEdgesFirst())
+			Convey("Then the edges of the vertices should be dropped first", func() {
+				So(err, ShouldBeNil)
+				So(server.queries[0], ShouldEqual, "g.V().hasLabel(b0).bothE().dedup().limit(400).id()")
+				So(server.edges, ShouldBeEmpty)
+				So(server.vertices, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestElementID(t *testing.T) {
+	Convey("Given the raw IDs of elements", t, func() {
+		Convey("When elementID is called", func() {
+			number, numberErr := elementID(json.RawMessage(`9007199254740993`))
+			str, strErr := elementID(json.RawMessage(`"abc"`))
+			relation, relationErr := elementID(json.RawMessage(`{"relationId":"4r8-3ao-2dh-39s"}`))
+			_, badErr := elementID(json.RawMessage(`{}`))
+			Convey("Then numbers should be kept exactly and other IDs returned as strings", func() {
+				So(numberErr, ShouldBeNil)
+				So(number, ShouldEqual, json.Number("9007199254740993"))
+				So(strErr, ShouldBeNil)
+				So(str, ShouldEqual, "abc")
+				So(relationErr, ShouldBeNil)
+				So(relation, ShouldEqual, "4r8-3ao-2dh-39s")
+				So(badErr, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/computer"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// ComputerQuery runs the traversal as an OLAP job on the computer
+// and unmarshals its results into v, which should be a pointer to a
+// slice. The traversal must start from the "g" source and leave
+// the computer to ComputerQuery rather than adding one itself.
+func (m *miscQueryManager) ComputerQuery(queryObj query.Query, c computer.Computer, v interface{}) error {
+	query, err := computerQuery(queryObj.String(), c)
+	if err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("ComputerQuery", queryObj.String(), err),
+		)
+		return err
+	}
+
+	responses, err := m.executeStringQuery(query)
+	if err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("ComputerQuery", query, err),
+		)
+		return err
+	}
+
+	if err = model.UnmarshalValues(responses, v); err != nil {
+		m.logger.Error("values unmarshal",
+			gremerror.NewGrammesError("ComputerQuery", err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// computerQuery starts the traversal from a
+// source configured with the withComputer() step.
+func computerQuery(query string, c computer.Computer) (string, error) {
+	if !strings.HasPrefix(query, "g.") {
+		return query, gremerror.NewValidationError("", 0,
+			"OLAP jobs must start from the \"g\" traversal source")
+	}
+
+	source := traversal.NewSource()
+	if c != "" {
+		source = source.WithComputer(c)
+	} else {
+		source = source.WithComputer()
+	}
+
+	return source.String() + strings.TrimPrefix(query, "g"), nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/computer"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+const pageRankResponse = `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","marko","rank",{"@type":"g:Double","@value":0.15}]}]}`
+
+type rank struct {
+	Name string  `json:"name"`
+	Rank float64 `json:"rank"`
+}
+
+func TestComputerQuery(t *testing.T) {
+	Convey("Given a string executor and misc query manager", t, func() {
+		var sent string
+		execute := func(q string) ([][]byte, error) {
+			sent = q
+			return [][]byte{[]byte(pageRankResponse)}, nil
+		}
+		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
+		g := traversal.NewTraversal()
+		Convey("When ComputerQuery is called", func() {
+			var results []rank
+			err := mm.ComputerQuery(g.V().PageRank().By("rank").ValueMap("name", "rank"),
+				computer.Compute(computer.SparkGraphComputer).Workers(4), &results)
+			Convey("Then the computer should be added to the traversal source", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "g.withComputer(Computer.compute(SparkGraphComputer).workers(4)).V().pageRank().by(\"rank\").valueMap(\"name\",\"rank\")")
+			})
+			Convey("Then the results should be unmarshalled", func() {
+				So(results, ShouldResemble, []rank{{Name: "marko", Rank: 0.15}})
+			})
+		})
+
+		Convey("When ComputerQuery is called without a computer", func() {
+			var results []rank
+			err := mm.ComputerQuery(g.V().PeerPressure(), "", &results)
+			Convey("Then the default computer should be used", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "g.withComputer().V().peerPressure()")
+			})
+		})
+
+		Convey("When ComputerQuery is called with a query it doesn't understand", func() {
+			var results []rank
+			err := mm.ComputerQuery(traversal.NewCustomTraversal("g.V().map{ it.get() }"), "", &results)
+			Convey("Then the query should be sent as it is after the computer", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "g.withComputer().V().map{ it.get() }")
+			})
+		})
+
+		Convey("When ComputerQuery is called with another traversal source", func() {
+			var results []rank
+			err := mm.ComputerQuery(traversal.NewCustomTraversal("graph.traversal().V()"), "", &results)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When ComputerQuery is called and the results can't be unmarshalled", func() {
+			var results []int
+			err := mm.ComputerQuery(g.V().PageRank(), "", &results)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a string executor that fails and misc query manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
+		Convey("When ComputerQuery is called", func() {
+			var results []rank
+			err := mm.ComputerQuery(traversal.NewTraversal().V().ConnectedComponent(), "", &results)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
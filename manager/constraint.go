@@ -0,0 +1,78 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/query/consistency"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+// AddVertexLabel adds the vertex label to the schema. A partitioned
+// label spreads its vertices across the cluster and a static
+// label's vertices can't be changed once they are committed.
+func (s *schemaManager) AddVertexLabel(label string, partitioned, static bool) error {
+	query := graph.NewManagement().MakeVertexLabel(label)
+	if partitioned {
+		query = query.Partition()
+	}
+	if static {
+		query = query.SetStatic()
+	}
+
+	_, err := s.ExecuteManagement(query.Make())
+	return err
+}
+
+// SetTTL makes the elements of the vertex label or edge label,
+// or the values of the property key, expire after the duration.
+func (s *schemaManager) SetTTL(t graph.SchemaType, ttl time.Duration) error {
+	_, err := s.ExecuteManagement(graph.NewManagement().SetTTL(t, ttl))
+	return err
+}
+
+// SetConsistency sets how the storage backend keeps the
+// property key, edge label or graph index consistent.
+func (s *schemaManager) SetConsistency(t graph.SchemaType, modifier consistency.Modifier) error {
+	_, err := s.ExecuteManagement(graph.NewManagement().SetConsistency(t, modifier))
+	return err
+}
+
+// AddProperties allows the property keys
+// on the vertex label or edge label.
+func (s *schemaManager) AddProperties(label graph.SchemaType, keys ...string) error {
+	_, err := s.ExecuteManagement(graph.NewManagement().AddProperties(label, keys...))
+	return err
+}
+
+// AddConnection allows the edge label
+// between the out and in vertex labels.
+func (s *schemaManager) AddConnection(label, outLabel, inLabel string) error {
+	_, err := s.ExecuteManagement(graph.NewManagement().AddConnection(label, outLabel, inLabel))
+	return err
+}
+
+// ChangeName renames the schema type.
+func (s *schemaManager) ChangeName(t graph.SchemaType, name string) error {
+	_, err := s.ExecuteManagement(graph.NewManagement().ChangeName(t, name))
+	return err
+}
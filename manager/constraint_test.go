@@ -0,0 +1,114 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/consistency"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+func TestSchemaConstraints(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var sent string
+		execute := func(q string) ([][]byte, error) {
+			sent = q
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AddVertexLabel is called", func() {
+			err := sm.AddVertexLabel("person", false, false)
+			Convey("Then the label should be made and committed", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.makeVertexLabel(\"person\").make();mgmt.commit()")
+			})
+		})
+
+		Convey("When AddVertexLabel is called for a partitioned static label", func() {
+			err := sm.AddVertexLabel("tweet", true, true)
+			Convey("Then the label should be partitioned and static", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.makeVertexLabel(\"tweet\").partition().setStatic().make();mgmt.commit()")
+			})
+		})
+
+		Convey("When SetTTL is called", func() {
+			err := sm.SetTTL(graph.VertexLabel("tweet"), time.Hour)
+			Convey("Then the TTL should be set", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.setTTL(mgmt.getVertexLabel(\"tweet\"),java.time.Duration.ofMillis(3600000));mgmt.commit()")
+			})
+		})
+
+		Convey("When SetConsistency is called", func() {
+			err := sm.SetConsistency(graph.PropertyKey("name"), consistency.Lock)
+			Convey("Then the consistency should be set", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.setConsistency(mgmt.getPropertyKey(\"name\"),ConsistencyModifier.LOCK);mgmt.commit()")
+			})
+		})
+
+		Convey("When AddProperties is called", func() {
+			err := sm.AddProperties(graph.EdgeLabel("knows"), "since")
+			Convey("Then the keys should be allowed on the label", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.addProperties(mgmt.getEdgeLabel(\"knows\"),mgmt.getPropertyKey(\"since\"));mgmt.commit()")
+			})
+		})
+
+		Convey("When AddConnection is called", func() {
+			err := sm.AddConnection("knows", "person", "person")
+			Convey("Then the connection should be added", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.addConnection(mgmt.getEdgeLabel(\"knows\"),mgmt.getVertexLabel(\"person\"),mgmt.getVertexLabel(\"person\"));mgmt.commit()")
+			})
+		})
+
+		Convey("When ChangeName is called", func() {
+			err := sm.ChangeName(graph.PropertyKey("nme"), "name")
+			Convey("Then the schema type should be renamed", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.changeName(mgmt.getPropertyKey(\"nme\"),\"name\");mgmt.commit()")
+			})
+		})
+	})
+
+	Convey("Given a string executor that fails and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When the constraint methods are called", func() {
+			Convey("Then the error should be returned", func() {
+				So(sm.AddVertexLabel("person", false, false), ShouldNotBeNil)
+				So(sm.SetTTL(graph.VertexLabel("tweet"), time.Hour), ShouldNotBeNil)
+				So(sm.SetConsistency(graph.PropertyKey("name"), consistency.Lock), ShouldNotBeNil)
+				So(sm.AddProperties(graph.VertexLabel("person"), "name"), ShouldNotBeNil)
+				So(sm.AddConnection("knows", "person", "person"), ShouldNotBeNil)
+				So(sm.ChangeName(graph.PropertyKey("nme"), "name"), ShouldNotBeNil)
+			})
+		})
+	})
+}
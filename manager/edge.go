@@ -0,0 +1,220 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"strconv"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// nilEdge is used for returning nothing
+// in an edge related function.
+var nilEdge = model.Edge{}
+
+type edgeQueryManager struct {
+	logger             logging.Logger
+	executeStringQuery stringExecutor
+	// executeBoundStringQuery is used for the
+	// queries that send their strings as bindings.
+	executeBoundStringQuery executor
+	version                 *serverVersion
+	schema                  *propertySchema
+}
+
+func newEdgeQueryManager(logger logging.Logger, executor stringExecutor) *edgeQueryManager {
+	return &edgeQueryManager{
+		logger:             logger,
+		executeStringQuery: executor,
+		version:            newServerVersion(executor),
+	}
+}
+
+// AddEdge adds an edge with the label and optional properties
+// going out of the vertex with the outID and into the vertex
+// with the inID, then returns the added edge.
+func (e *edgeQueryManager) AddEdge(outID, inID int64, label string, properties ...interface{}) (model.Edge, error) {
+	if len(properties)%2 != 0 {
+		e.logger.Error("number of parameters ["+strconv.Itoa(len(properties))+"]",
+			gremerror.NewGrammesError("AddEdge", gremerror.ErrOddNumberOfParameters),
+		)
+		return nilEdge, gremerror.ErrOddNumberOfParameters
+	}
+
+	if err := e.schema.validate(properties...); err != nil {
+		e.logger.Error("AddEdge: invalid property", err)
+		return nilEdge, err
+	}
+
+	query := traversal.NewTraversal().V().HasID(outID).AddE(label).To(traversal.NewTraversal().V().HasID(inID).Raw())
+	for i := 0; i < len(properties); i += 2 {
+		query.AddStep("property", properties[i], properties[i+1])
+	}
+
+	edges, err := e.EdgesByString(query.String())
+	if err != nil {
+		e.logger.Error("error adding edge",
+			gremerror.NewGrammesError("AddEdge", err),
+		)
+		return nilEdge, err
+	}
+
+	// No edge is added when either vertex doesn't exist.
+	if len(edges) == 0 {
+		return nilEdge, gremerror.NewGrammesError("AddEdge", gremerror.ErrEmptyResponse)
+	}
+
+	return edges[0], nil
+}
+
+// EdgesByString will gather any edges and return them
+// based on the fed in string query.
+func (e *edgeQueryManager) EdgesByString(query string) ([]model.Edge, error) {
+	responses, err := e.executeStringQuery(query)
+	if err != nil {
+		e.logger.Error("invalid query",
+			gremerror.NewQueryError("Edges", query, err),
+		)
+		return nil, err
+	}
+
+	edges, err := model.UnmarshalEdgeList(responses)
+	if err != nil {
+		e.logger.Error("edges unmarshal", err)
+		return nil, err
+	}
+
+	e.logger.Debug("RESPONSE INFO", map[string]interface{}{"FULL LENGTH": len(edges)})
+
+	return edges, nil
+}
+
+// EdgesByQuery will gather any edges and return them
+// based on the fed in traversal query.
+func (e *edgeQueryManager) EdgesByQuery(query query.Query) ([]model.Edge, error) {
+	edges, err := e.EdgesByString(query.String())
+	if err != nil {
+		e.logger.Error("error gathering edges",
+			gremerror.NewGrammesError("EdgesByQuery", err),
+		)
+	}
+	return edges, err
+}
+
+// EdgeByID will get the edge with the given ID.
+func (e *edgeQueryManager) EdgeByID(id string) (model.Edge, error) {
+	query := traversal.NewTraversal().E().HasID(id)
+
+	edges, err := e.EdgesByString(query.String())
+	if err != nil {
+		e.logger.Error("error gathering edges",
+			gremerror.NewGrammesError("EdgeByID", err),
+		)
+		return nilEdge, err
+	}
+
+	if len(edges) == 0 {
+		return nilEdge, gremerror.NewGrammesError("EdgeByID", gremerror.ErrEmptyResponse)
+	}
+
+	return edges[0], nil
+}
+
+// Edges will return the edges with the
+// label and matching the properties.
+func (e *edgeQueryManager) Edges(label string, properties ...interface{}) ([]model.Edge, error) {
+	if len(properties)%2 != 0 {
+		e.logger.Error("number of parameters ["+strconv.Itoa(len(properties))+"]",
+			gremerror.NewGrammesError("Edges", gremerror.ErrOddNumberOfParameters),
+		)
+		return nil, gremerror.ErrOddNumberOfParameters
+	}
+
+	query := traversal.NewTraversal().E().HasLabel(label)
+	for i := 0; i < len(properties); i += 2 {
+		query = query.Has(properties[i], properties[i+1])
+	}
+
+	edges, err := e.EdgesByString(query.String())
+	if err != nil {
+		e.logger.Error("error gathering edges",
+			gremerror.NewGrammesError("Edges", err),
+		)
+	}
+
+	return edges, err
+}
+
+// DropEdge drops the edges with the given IDs.
+func (e *edgeQueryManager) DropEdge(ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := traversal.NewTraversal().E().HasID(ids[0], ids[1:]...).Drop()
+	if _, err := e.executeStringQuery(query.String()); err != nil {
+		e.logger.Error("invalid query",
+			gremerror.NewQueryError("DropEdge", query.String(), err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// SetEdgeProperty will add or set the
+// properties of the edge with the given ID.
+func (e *edgeQueryManager) SetEdgeProperty(id string, keyAndVals ...interface{}) error {
+	if len(keyAndVals)%2 != 0 {
+		e.logger.Error("number of parameters ["+strconv.Itoa(len(keyAndVals))+"]",
+			gremerror.NewGrammesError("SetEdgeProperty", gremerror.ErrOddNumberOfParameters),
+		)
+		return gremerror.ErrOddNumberOfParameters
+	}
+
+	if err := e.schema.validate(keyAndVals...); err != nil {
+		e.logger.Error("invalid property", err)
+		return err
+	}
+
+	query := traversal.NewTraversal().E().HasID(id)
+	for i := 0; i < len(keyAndVals); i += 2 {
+		query.AddStep("property", keyAndVals[i], keyAndVals[i+1])
+	}
+
+	if _, err := e.executeStringQuery(query.String()); err != nil {
+		e.logger.Error("invalid query",
+			gremerror.NewQueryError("SetEdgeProperty", query.String(), err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// EdgeCount retrieves the number of edges
+// that are currently on the graph as an int64.
+func (e *edgeQueryManager) EdgeCount() (int64, error) {
+	return count(e.logger, e.executeStringQuery, "EdgeCount", traversal.NewTraversal().E().Count())
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+)
+
+var edgeListResponse = `[{"@type":"g:Edge","@value":{"id":{"@type":"janusgraph:RelationIdentifier","@value":{"relationId":"zz0-yxs-25rf9-1548"}},` +
+	`"inV":{"@type":"g:Int64","@value":53288},"inVLabel":"person","label":"knows",` +
+	`"outV":{"@type":"g:Int64","@value":45280},"outVLabel":"person"}}]`
+
+func TestAddEdge(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return [][]byte{[]byte(edgeListResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When AddEdge is called with properties", func() {
+			edge, err := em.AddEdge(45280, 53288, "knows", "since", 2010)
+			Convey("Then the edge should be added between the vertices", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, `g.V().hasId(45280).addE("knows").to(V().hasId(53288)).property("since",2010)`)
+				So(edge.ID(), ShouldEqual, "zz0-yxs-25rf9-1548")
+				So(edge.OutVertexID(), ShouldEqual, 45280)
+			})
+		})
+		Convey("When AddEdge is called with an odd number of parameters", func() {
+			_, err := em.AddEdge(1, 2, "knows", "since")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrOddNumberOfParameters)
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns no edges", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte("[]")}, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When AddEdge is called", func() {
+			_, err := em.AddEdge(1, 2, "knows")
+			Convey("Then an empty response error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns an error", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When AddEdge is called", func() {
+			_, err := em.AddEdge(1, 2, "knows")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesByString(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(edgeListResponse)}, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgesByString is called", func() {
+			edges, err := em.EdgesByString("g.E()")
+			Convey("Then the edges should be returned", func() {
+				So(err, ShouldBeNil)
+				So(edges, ShouldHaveLength, 1)
+				So(edges[0].Label(), ShouldEqual, "knows")
+			})
+		})
+		Convey("When EdgesByQuery is called", func() {
+			var q mockQuery
+			edges, err := em.EdgesByQuery(q)
+			Convey("Then the edges should be returned", func() {
+				So(err, ShouldBeNil)
+				So(edges, ShouldHaveLength, 1)
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns an invalid response", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte("{")}, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgesByString is called", func() {
+			_, err := em.EdgesByString("g.E()")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns an error", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgesByQuery is called", func() {
+			var q mockQuery
+			_, err := em.EdgesByQuery(q)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeByID(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return [][]byte{[]byte(edgeListResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeByID is called", func() {
+			edge, err := em.EdgeByID("zz0-yxs-25rf9-1548")
+			Convey("Then the edge with the ID should be returned", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, `g.E().hasId("zz0-yxs-25rf9-1548")`)
+				So(edge.ID(), ShouldEqual, "zz0-yxs-25rf9-1548")
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns no edges", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte("[]")}, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeByID is called", func() {
+			_, err := em.EdgeByID("1")
+			Convey("Then an empty response error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdges(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return [][]byte{[]byte(edgeListResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When Edges is called with properties", func() {
+			edges, err := em.Edges("knows", "since", 2010)
+			Convey("Then the matching edges should be returned", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, `g.E().hasLabel("knows").has("since",2010)`)
+				So(edges, ShouldHaveLength, 1)
+			})
+		})
+		Convey("When Edges is called with an odd number of parameters", func() {
+			_, err := em.Edges("knows", "since")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrOddNumberOfParameters)
+			})
+		})
+	})
+}
+
+func TestDropEdge(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var queries []string
+		execute := func(q string) ([][]byte, error) {
+			queries = append(queries, q)
+			return nil, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When DropEdge is called with IDs", func() {
+			err := em.DropEdge("a", "b")
+			Convey("Then the edges should be dropped in one query", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldResemble, []string{`g.E().hasId("a","b").drop()`})
+			})
+		})
+		Convey("When DropEdge is called without IDs", func() {
+			err := em.DropEdge()
+			Convey("Then nothing should be sent", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns an error", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When DropEdge is called", func() {
+			err := em.DropEdge("a")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSetEdgeProperty(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return nil, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When SetEdgeProperty is called", func() {
+			err := em.SetEdgeProperty("a", "since", 2010, "weight", 0.5)
+			Convey("Then the properties should be set on the edge", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, `g.E().hasId("a").property("since",2010).property("weight",0.5)`)
+			})
+		})
+		Convey("When SetEdgeProperty is called with an odd number of parameters", func() {
+			err := em.SetEdgeProperty("a", "since")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrOddNumberOfParameters)
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns an error", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When SetEdgeProperty is called", func() {
+			err := em.SetEdgeProperty("a", "since", 2010)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeCount(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return [][]byte{[]byte(idResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeCount is called", func() {
+			c, err := em.EdgeCount()
+			Convey("Then the count should equal 255", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, "g.E().count()")
+				So(c, ShouldEqual, 255)
+			})
+		})
+	})
+
+	Convey("Given a string executor that returns an error", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeCount is called", func() {
+			_, err := em.EdgeCount()
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -23,15 +23,19 @@ package manager
 import (
 	"github.com/northwesternmutual/grammes/gremconnect"
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/dialect"
 )
 
 // GraphQueryManager has all the function related to interacting with the graph.
 type GraphQueryManager struct {
 	*queryManager
 	*vertexQueryManager
+	*edgeQueryManager
+	*bulkQueryManager
 	*miscQueryManager
 	*schemaManager
 
+	schema *propertySchema
 	dialer gremconnect.Dialer
 	logger logging.Logger
 }
@@ -44,9 +48,28 @@ func NewGraphManager(dialer gremconnect.Dialer, logger logging.Logger, executeRe
 	}
 
 	g.vertexQueryManager = newVertexQueryManager(logger, g.ExecuteStringQuery)
+	g.edgeQueryManager = newEdgeQueryManager(logger, g.ExecuteStringQuery)
+	g.bulkQueryManager = newBulkQueryManager(logger, g.ExecuteBoundStringQuery)
 	g.miscQueryManager = newMiscQueryManager(logger, g.ExecuteStringQuery)
 	g.schemaManager = newSchemaManager(logger, g.ExecuteStringQuery)
 
+	// The property keys are shared so a schema change through
+	// the schema manager is seen when adding properties.
+	g.schema = newPropertySchema(g.schemaManager.PropertyKeys)
+	g.schemaManager.schema = g.schema
+	g.miscQueryManager.schema = g.schema
+	g.edgeQueryManager.schema = g.schema
+	g.bulkQueryManager.schema = g.schema
+	g.vertexQueryManager.addVertexQueryManager.schema = g.schema
+
+	// The server version is shared so it's only looked up once.
+	g.edgeQueryManager.version = g.vertexQueryManager.addVertexQueryManager.version
+
+	// Upserts and migration records send their strings as bindings.
+	g.vertexQueryManager.addVertexQueryManager.executeBoundStringQuery = g.ExecuteBoundStringQuery
+	g.edgeQueryManager.executeBoundStringQuery = g.ExecuteBoundStringQuery
+	g.schemaManager.executeBoundStringQuery = g.ExecuteBoundStringQuery
+
 	return g
 }
 
@@ -56,10 +79,40 @@ func (g *GraphQueryManager) SetLogger(newLogger logging.Logger) {
 	g.queryManager.logger = newLogger
 	g.schemaManager.logger = newLogger
 	g.miscQueryManager.logger = newLogger
+	g.edgeQueryManager.logger = newLogger
+	g.bulkQueryManager.logger = newLogger
 	g.vertexQueryManager.addVertexQueryManager.logger = newLogger
 	g.vertexQueryManager.getVertexQueryManager.logger = newLogger
 }
 
+// SetStrictValidation determines whether every traversal is validated
+// against TinkerPop's grammar before being sent to the server.
+func (g *GraphQueryManager) SetStrictValidation(strict bool) {
+	g.queryManager.strict = strict
+}
+
+// SetDialect sets the Gremlin provider that every query is rendered
+// for. Queries the provider doesn't support are rejected before they
+// are sent to the server.
+func (g *GraphQueryManager) SetDialect(d dialect.Dialect) {
+	g.queryManager.dialect = d
+}
+
+// SetSchemaValidation determines whether property values are checked
+// against the data types and cardinalities of their property keys
+// before they're added. The property keys are read from the schema
+// the first time they're needed and again after the schema is
+// changed through the SchemaQuerier.
+func (g *GraphQueryManager) SetSchemaValidation(enabled bool) {
+	g.schema.setEnabled(enabled)
+}
+
+// ValidateProperties checks the property keys and values against
+// the schema when schema validation is enabled.
+func (g *GraphQueryManager) ValidateProperties(keyAndVals ...interface{}) error {
+	return g.schema.validate(keyAndVals...)
+}
+
 // MiscQuerier returns the manager for miscellaneous queries.
 func (g *GraphQueryManager) MiscQuerier() MiscQuerier {
 	return g.miscQueryManager
@@ -90,6 +143,16 @@ func (g *GraphQueryManager) VertexQuerier() VertexQuerier {
 	return g.vertexQueryManager
 }
 
+// EdgeQuerier returns the manager for all edge related queries.
+func (g *GraphQueryManager) EdgeQuerier() EdgeQuerier {
+	return g.edgeQueryManager
+}
+
+// BulkQuerier returns the manager for operations on many elements at a time.
+func (g *GraphQueryManager) BulkQuerier() BulkQuerier {
+	return g.bulkQueryManager
+}
+
 // ExecuteQuerier returns the manager for executing the raw queries.
 func (g *GraphQueryManager) ExecuteQuerier() ExecuteQuerier {
 	return g.queryManager
@@ -27,6 +27,7 @@ import (
 
 	"github.com/northwesternmutual/grammes/gremconnect"
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/dialect"
 )
 
 func TestSetLogger(t *testing.T) {
@@ -124,6 +125,34 @@ func TestVertexQuerier(t *testing.T) {
 	})
 }
 
+func TestEdgeQuerier(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When EdgeQuerier is called", func() {
+			eq := gm.EdgeQuerier()
+			Convey("Then we should return the edge querier", func() {
+				So(eq, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestBulkQuerier(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When BulkQuerier is called", func() {
+			bq := gm.BulkQuerier()
+			Convey("Then we should return the bulk querier", func() {
+				So(bq, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestExecuteQuerier(t *testing.T) {
 	Convey("Given a dialer, string executor and graph query manager", t, func() {
 		dialer := gremconnect.NewWebSocketDialer("testaddress")
@@ -151,3 +180,39 @@ func TestSchemaQuerier(t *testing.T) {
 		})
 	})
 }
+
+func TestSetStrictValidation(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When SetStrictValidation is called", func() {
+			gm.SetStrictValidation(true)
+			Convey("Then the query manager should be strict", func() {
+				So(gm.queryManager.strict, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestSetDialect(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When SetDialect is called", func() {
+			gm.SetDialect(dialect.CosmosDB)
+			Convey("Then the query manager should use the dialect", func() {
+				So(gm.queryManager.dialect, ShouldEqual, dialect.CosmosDB)
+			})
+		})
+
+		Convey("When a schema query is made for a provider without the management API", func() {
+			gm.SetDialect(dialect.Neptune)
+			_, err := gm.SchemaQuerier().AddPropertyKey("name", "String.class", "SINGLE")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// ExecuteManagement runs the statements in a single management
+// transaction which is committed once they have all been applied.
+// Statements start from graph.NewManagement().
+func (s *schemaManager) ExecuteManagement(statements ...graph.String) ([][]byte, error) {
+	query := graph.Management(statements...)
+
+	data, err := s.executeStringQuery(query.String())
+	if err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError("ExecuteManagement", query.String(), err),
+		)
+		return nil, err
+	}
+	s.schema.reset()
+
+	return data, nil
+}
+
+// AddCompositeIndex builds a composite index of the property
+// keys for the vertices, edges or vertex properties. A unique
+// index allows one element for each combination of values.
+func (s *schemaManager) AddCompositeIndex(name string, element index.Element, unique bool, keys ...string) error {
+	if len(keys) == 0 {
+		s.logger.Error("index ["+name+"]",
+			gremerror.NewGrammesError("AddCompositeIndex", gremerror.ErrNoIndexKeys),
+		)
+		return gremerror.ErrNoIndexKeys
+	}
+
+	query := graph.NewManagement().BuildIndex(name, element)
+	for _, k := range keys {
+		query = query.AddKey(k)
+	}
+	if unique {
+		query = query.Unique()
+	}
+
+	_, err := s.ExecuteManagement(query.BuildCompositeIndex())
+	return err
+}
+
+// AddMixedIndex builds a mixed index of the property keys, each
+// with an optional mapping, in the indexing backend such as "search".
+func (s *schemaManager) AddMixedIndex(name string, element index.Element, backend string, keys ...index.Key) error {
+	if len(keys) == 0 {
+		s.logger.Error("index ["+name+"]",
+			gremerror.NewGrammesError("AddMixedIndex", gremerror.ErrNoIndexKeys),
+		)
+		return gremerror.ErrNoIndexKeys
+	}
+
+	query := graph.NewManagement().BuildIndex(name, element)
+	for _, k := range keys {
+		if k.Mapping != "" {
+			query = query.AddKey(k.Name, k.Mapping)
+		} else {
+			query = query.AddKey(k.Name)
+		}
+	}
+
+	_, err := s.ExecuteManagement(query.BuildMixedIndex(backend))
+	return err
+}
+
+// AddEdgeIndex builds a vertex-centric index for the edges
+// with the label, sorted by the property keys in the order.
+func (s *schemaManager) AddEdgeIndex(label, name string, dir direction.Direction, sort order.Order, keys ...string) error {
+	if len(keys) == 0 {
+		s.logger.Error("index ["+name+"]",
+			gremerror.NewGrammesError("AddEdgeIndex", gremerror.ErrNoIndexKeys),
+		)
+		return gremerror.ErrNoIndexKeys
+	}
+
+	_, err := s.ExecuteManagement(graph.NewManagement().BuildEdgeIndex(label, name, dir, sort, keys...))
+	return err
+}
+
+// UpdateIndex applies the action to the graph index
+// and waits for the job, such as a reindex, to finish.
+func (s *schemaManager) UpdateIndex(name string, action index.Action) error {
+	_, err := s.ExecuteManagement(graph.NewManagement().UpdateIndex(name, action).Get())
+	return err
+}
+
+// UpdateEdgeIndex applies the action to the vertex-centric
+// index on the edge label and waits for the job to finish.
+func (s *schemaManager) UpdateEdgeIndex(label, name string, action index.Action) error {
+	_, err := s.ExecuteManagement(graph.NewManagement().UpdateEdgeIndex(label, name, action).Get())
+	return err
+}
+
+// AwaitGraphIndexStatus waits until every instance
+// reports the status for the graph index.
+func (s *schemaManager) AwaitGraphIndexStatus(name string, status index.Status, timeout ...time.Duration) error {
+	query := graph.AwaitGraphIndexStatus(name, status, timeout...)
+
+	if _, err := s.executeStringQuery(query.String()); err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError("AwaitGraphIndexStatus", query.String(), err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// AwaitEdgeIndexStatus waits until every instance reports
+// the status for the vertex-centric index on the edge label.
+func (s *schemaManager) AwaitEdgeIndexStatus(label, name string, status index.Status, timeout ...time.Duration) error {
+	query := graph.AwaitEdgeIndexStatus(label, name, status, timeout...)
+
+	if _, err := s.executeStringQuery(query.String()); err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError("AwaitEdgeIndexStatus", query.String(), err),
+		)
+		return err
+	}
+
+	return nil
+}
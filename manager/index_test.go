@@ -0,0 +1,135 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+func TestIndexManagement(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var sent string
+		execute := func(q string) ([][]byte, error) {
+			sent = q
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When ExecuteManagement is called", func() {
+			_, err := sm.ExecuteManagement(graph.NewManagement().MakeVertexLabel("person").Make())
+			Convey("Then the statements should be committed together", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.makeVertexLabel(\"person\").make();mgmt.commit()")
+			})
+		})
+
+		Convey("When AddCompositeIndex is called", func() {
+			err := sm.AddCompositeIndex("byName", index.Vertex, true, "name", "age")
+			Convey("Then a unique composite index should be built", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.buildIndex(\"byName\",Vertex.class).addKey(mgmt.getPropertyKey(\"name\")).addKey(mgmt.getPropertyKey(\"age\")).unique().buildCompositeIndex();mgmt.commit()")
+			})
+		})
+
+		Convey("When AddMixedIndex is called", func() {
+			err := sm.AddMixedIndex("search", index.Vertex, "search", index.Key{Name: "bio", Mapping: index.Text}, index.Key{Name: "age"})
+			Convey("Then a mixed index should be built with the mappings", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.buildIndex(\"search\",Vertex.class).addKey(mgmt.getPropertyKey(\"bio\"),Mapping.TEXT.asParameter()).addKey(mgmt.getPropertyKey(\"age\")).buildMixedIndex(\"search\");mgmt.commit()")
+			})
+		})
+
+		Convey("When AddEdgeIndex is called", func() {
+			err := sm.AddEdgeIndex("battled", "battlesByTime", direction.Out, order.Asc, "time")
+			Convey("Then a vertex-centric index should be built", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.buildEdgeIndex(mgmt.getEdgeLabel(\"battled\"),\"battlesByTime\",OUT,Order.asc,mgmt.getPropertyKey(\"time\"));mgmt.commit()")
+			})
+		})
+
+		Convey("When an index is built without keys", func() {
+			Convey("Then every builder should return an error", func() {
+				So(sm.AddCompositeIndex("byName", index.Vertex, false), ShouldEqual, gremerror.ErrNoIndexKeys)
+				So(sm.AddMixedIndex("search", index.Vertex, "search"), ShouldEqual, gremerror.ErrNoIndexKeys)
+				So(sm.AddEdgeIndex("battled", "battlesByTime", direction.Out, order.Asc), ShouldEqual, gremerror.ErrNoIndexKeys)
+			})
+		})
+
+		Convey("When UpdateIndex is called", func() {
+			err := sm.UpdateIndex("byName", index.Reindex)
+			Convey("Then the job should be waited for", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.updateIndex(mgmt.getGraphIndex(\"byName\"),SchemaAction.REINDEX).get();mgmt.commit()")
+			})
+		})
+
+		Convey("When UpdateEdgeIndex is called", func() {
+			err := sm.UpdateEdgeIndex("battled", "battlesByTime", index.Disable)
+			Convey("Then the relation index should be updated", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "mgmt = graph.openManagement();mgmt.updateIndex(mgmt.getRelationIndex(mgmt.getEdgeLabel(\"battled\"),\"battlesByTime\"),SchemaAction.DISABLE_INDEX).get();mgmt.commit()")
+			})
+		})
+
+		Convey("When AwaitGraphIndexStatus is called", func() {
+			err := sm.AwaitGraphIndexStatus("byName", index.Registered, time.Second)
+			Convey("Then the status should be waited for", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "ManagementSystem.awaitGraphIndexStatus(graph,\"byName\").status(SchemaStatus.REGISTERED).timeout(1000,java.time.temporal.ChronoUnit.MILLIS).call()")
+			})
+		})
+
+		Convey("When AwaitEdgeIndexStatus is called", func() {
+			err := sm.AwaitEdgeIndexStatus("battled", "battlesByTime", index.Enabled)
+			Convey("Then the status should be waited for", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "ManagementSystem.awaitRelationIndexStatus(graph,\"battlesByTime\",\"battled\").status(SchemaStatus.ENABLED).call()")
+			})
+		})
+	})
+}
+
+func TestIndexManagementQueryError(t *testing.T) {
+	Convey("Given a string executor that fails and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When the index methods are called", func() {
+			Convey("Then the error should be returned", func() {
+				_, err := sm.ExecuteManagement()
+				So(err, ShouldNotBeNil)
+				So(sm.AddCompositeIndex("byName", index.Vertex, false, "name"), ShouldNotBeNil)
+				So(sm.UpdateIndex("byName", index.Enable), ShouldNotBeNil)
+				So(sm.AwaitGraphIndexStatus("byName", index.Enabled), ShouldNotBeNil)
+				So(sm.AwaitEdgeIndexStatus("battled", "battlesByTime", index.Enabled), ShouldNotBeNil)
+			})
+		})
+	})
+}
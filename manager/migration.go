@@ -0,0 +1,210 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/literal"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// MigrationLabel is the vertex label of the records
+// kept in the graph for the applied migrations.
+const MigrationLabel = "grammesMigration"
+
+const (
+	migrationVersionKey     = "migrationVersion"
+	migrationDescriptionKey = "migrationDescription"
+	migrationChecksumKey    = "migrationChecksum"
+	migrationAppliedAtKey   = "migrationAppliedAt"
+)
+
+// migrationSchema is the schema of the migration records
+// so they can be kept in graphs that require a schema.
+var migrationSchema = model.Schema{
+	PropertyKeys: []model.PropertyKey{
+		{Name: migrationVersionKey, DataType: datatype.Long},
+		{Name: migrationDescriptionKey, DataType: datatype.String},
+		{Name: migrationChecksumKey, DataType: datatype.String},
+		{Name: migrationAppliedAtKey, DataType: datatype.Long},
+	},
+	VertexLabels: []model.VertexLabel{{Name: MigrationLabel}},
+}
+
+// AppliedMigrations returns the records of the
+// migrations that have been applied, by version.
+func (s *schemaManager) AppliedMigrations() ([]model.AppliedMigration, error) {
+	query := traversal.NewTraversal().V().HasLabel(MigrationLabel).
+		Order().By(migrationVersionKey).
+		Project("version", "description", "checksum", "appliedAt").
+		By(migrationVersionKey).By(migrationDescriptionKey).
+		By(migrationChecksumKey).By(migrationAppliedAtKey)
+
+	var applied []model.AppliedMigration
+	err := s.readSchema("AppliedMigrations", query.String(), &applied)
+
+	return applied, err
+}
+
+// Migrate applies the migrations that haven't been applied yet
+// in the order of their versions, each in its own management
+// transaction, and records them in the graph. Nothing is run
+// when a migration that has already been applied has changed
+// since. Migrations applied by others that aren't given are
+// left alone. It returns the migrations applied by this call.
+//
+// Each migration's script first checks that it hasn't been recorded
+// in the meantime and fails without running it if it has, so a second
+// runner that started at the same time stops with an error. The check
+// isn't a lock though: two scripts for the same version that run at
+// the very same time can both pass it, so Migrate should be run from
+// a single process, such as a deploy step, rather than on every start.
+func (s *schemaManager) Migrate(migrations ...model.Migration) ([]model.AppliedMigration, error) {
+	ordered := append([]model.Migration(nil), migrations...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Version < ordered[j].Version
+	})
+
+	for i := 1; i < len(ordered); i++ {
+		if ordered[i].Version == ordered[i-1].Version {
+			err := fmt.Errorf("%w: version %d", gremerror.ErrDuplicateMigration, ordered[i].Version)
+			s.logger.Error("invalid migrations", gremerror.NewGrammesError("Migrate", err))
+			return nil, err
+		}
+	}
+
+	if _, err := s.ApplySchema(migrationSchema); err != nil {
+		return nil, err
+	}
+
+	records, err := s.AppliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(map[int64]model.AppliedMigration, len(records))
+	for _, r := range records {
+		done[r.Version] = r
+	}
+
+	for _, m := range ordered {
+		if r, ok := done[m.Version]; ok && r.Checksum != m.Checksum() {
+			err = fmt.Errorf("%w: version %d", gremerror.ErrMigrationChanged, m.Version)
+			s.logger.Error("invalid migrations", gremerror.NewGrammesError("Migrate", err))
+			return nil, err
+		}
+	}
+
+	var applied []model.AppliedMigration
+
+	for _, m := range ordered {
+		if _, ok := done[m.Version]; ok {
+			continue
+		}
+
+		r, err := s.applyMigration(m)
+		if err != nil {
+			return applied, err
+		}
+
+		applied = append(applied, r)
+	}
+
+	return applied, nil
+}
+
+// applyMigration runs the migration and records it in a single
+// script, after checking that no record exists for its version.
+// The record is written before the management transaction
+// is committed so a statement or record that fails leaves neither
+// behind. The record is only lost when the graph transaction fails
+// to commit after the management transaction has, in which case
+// the migration is run again by the next Migrate.
+func (s *schemaManager) applyMigration(m model.Migration) (model.AppliedMigration, error) {
+	diff, err := s.DiffSchema(m.Schema)
+	if err != nil {
+		return model.AppliedMigration{}, err
+	}
+
+	if err = diff.Err(); err != nil {
+		s.logger.Error("migration "+strconv.FormatInt(m.Version, 10), err)
+		return model.AppliedMigration{}, err
+	}
+
+	r := model.AppliedMigration{
+		Version:     m.Version,
+		Description: m.Description,
+		Checksum:    m.Checksum(),
+		AppliedAt:   time.Now().UnixNano() / int64(time.Millisecond),
+	}
+
+	// The description is bound since it's free text.
+	b := newBindings()
+	record := traversal.NewTraversal().AddV(MigrationLabel)
+	record.AddStep("property", migrationVersionKey, longValue(r.Version))
+	record.AddStep("property", migrationDescriptionKey, b.bind(r.Description))
+	record.AddStep("property", migrationChecksumKey, b.bind(r.Checksum))
+	record.AddStep("property", migrationAppliedAtKey, longValue(r.AppliedAt))
+
+	statements := append([]graph.String{migrationGuard(m.Version)}, schemaStatements(diff.Missing)...)
+	statements = append(statements, m.Statements...)
+	query := graph.Management(append(statements, graph.String(record.Iterate().String()))...)
+
+	if _, err = s.executeBoundStringQuery(query.String(), b.values, map[string]string{}); err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError("Migrate", query.String(), err),
+		)
+		return model.AppliedMigration{}, err
+	}
+	s.schema.reset()
+
+	s.logger.Debug("Applied Migration", map[string]interface{}{
+		"Version":     r.Version,
+		"Description": r.Description,
+	})
+
+	return r, nil
+}
+
+// migrationGuard fails the script before anything is changed
+// when the migration has already been recorded by another runner.
+func migrationGuard(version int64) graph.String {
+	recorded := traversal.NewTraversal().V().HasLabel(MigrationLabel)
+	recorded.AddStep("has", migrationVersionKey, longValue(version))
+
+	return graph.String("if (" + recorded.HasNext().String() + ") {" +
+		graph.NewManagement().String() + ".rollback();" +
+		"throw new IllegalStateException(" + literal.Quote("migration "+strconv.FormatInt(version, 10)+" has already been applied") + ")" +
+		"}")
+}
+
+// longValue renders the number as a Groovy long.
+func longValue(n int64) traversal.Number {
+	return traversal.Number(strconv.FormatInt(n, 10) + "L")
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+var migrations = []model.Migration{
+	{
+		Version:     2,
+		Description: "person names",
+		Schema:      model.Schema{VertexLabels: []model.VertexLabel{{Name: "person"}}},
+		Statements:  []graph.String{graph.NewManagement().MakeEdgeLabel("knows").Make()},
+	},
+	{
+		Version:     1,
+		Description: "names",
+		Schema:      model.Schema{PropertyKeys: []model.PropertyKey{{Name: "name", DataType: datatype.String}}},
+	},
+}
+
+// migrationExecutor answers the schema reads for an empty graph
+// and the migration records with the given records.
+func migrationExecutor(records string, sent *[]string) stringExecutor {
+	return func(q string) ([][]byte, error) {
+		*sent = append(*sent, q)
+		if strings.HasPrefix(q, "g.V().hasLabel(\""+MigrationLabel+"\")") && records != "" {
+			return [][]byte{[]byte(records)}, nil
+		}
+		return nil, nil
+	}
+}
+
+// newMigrationManager returns a schema manager that answers with
+// the migration executor and keeps the bindings of bound scripts.
+func newMigrationManager(records string, sent *[]string, bindings *[]map[string]string) *schemaManager {
+	execute := migrationExecutor(records, sent)
+	sm := newSchemaManager(logging.NewNilLogger(), execute)
+	sm.executeBoundStringQuery = func(q string, b, _ map[string]string) ([][]byte, error) {
+		*bindings = append(*bindings, b)
+		return execute(q)
+	}
+	return sm
+}
+
+func TestMigrate(t *testing.T) {
+	Convey("Given a graph without applied migrations and schema manager", t, func() {
+		var (
+			sent     []string
+			bindings []map[string]string
+		)
+		sm := newMigrationManager("", &sent, &bindings)
+		Convey("When Migrate is called", func() {
+			applied, err := sm.Migrate(migrations...)
+			Convey("Then every migration should be applied in order", func() {
+				So(err, ShouldBeNil)
+				So(applied, ShouldHaveLength, 2)
+				So(applied[0].Version, ShouldEqual, 1)
+				So(applied[0].Checksum, ShouldEqual, migrations[1].Checksum())
+				So(applied[1].Version, ShouldEqual, 2)
+			})
+			Convey("Then the migration schema should be created first", func() {
+				So(sent[5], ShouldStartWith, "mgmt = graph.openManagement();mgmt.makePropertyKey(\"migrationVersion\").dataType(Long.class)")
+			})
+			Convey("Then each migration should be run and recorded in a single script", func() {
+				var runs []string
+				for _, q := range sent {
+					if strings.Contains(q, "mgmt.commit()") {
+						runs = append(runs, q)
+					}
+				}
+				So(runs, ShouldHaveLength, 3)
+				So(runs[0], ShouldNotContainSubstring, "g.addV")
+				So(runs[1], ShouldContainSubstring, "mgmt.makePropertyKey(\"name\")")
+				So(runs[1], ShouldContainSubstring, ";g.addV(\"grammesMigration\").property(\"migrationVersion\",1L).property(\"migrationDescription\",b0).property(\"migrationChecksum\",b1)")
+				So(runs[2], ShouldStartWith, "mgmt = graph.openManagement();"+
+					"if (g.V().hasLabel(\"grammesMigration\").has(\"migrationVersion\",2L).hasNext()) {"+
+					"mgmt.rollback();throw new IllegalStateException(\"migration 2 has already been applied\")};"+
+					"mgmt.makeVertexLabel(\"person\").make();mgmt.makeEdgeLabel(\"knows\").make();g.addV(\"grammesMigration\")")
+				So(runs[2], ShouldEndWith, ".iterate();mgmt.commit()")
+			})
+			Convey("Then the description and checksum should be bound", func() {
+				last := bindings[len(bindings)-1]
+				So(last, ShouldResemble, map[string]string{"b0": "person names", "b1": migrations[0].Checksum()})
+			})
+		})
+
+		Convey("When Migrate is called with duplicate versions", func() {
+			_, err := sm.Migrate(migrations[0], migrations[0])
+			Convey("Then nothing should be run", func() {
+				So(errors.Is(err, gremerror.ErrDuplicateMigration), ShouldBeTrue)
+				So(sent, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a graph with an applied migration and schema manager", t, func() {
+		var (
+			sent     []string
+			bindings []map[string]string
+		)
+		records := `[{"version":1,"description":"names","checksum":"` + migrations[1].Checksum() + `","appliedAt":1}]`
+		sm := newMigrationManager(records, &sent, &bindings)
+		Convey("When Migrate is called", func() {
+			applied, err := sm.Migrate(migrations...)
+			Convey("Then only the pending migration should be applied", func() {
+				So(err, ShouldBeNil)
+				So(applied, ShouldHaveLength, 1)
+				So(applied[0].Version, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When Migrate is called after the applied migration changed", func() {
+			changed := migrations[1]
+			changed.Schema.PropertyKeys = []model.PropertyKey{{Name: "name", DataType: datatype.Integer}}
+			applied, err := sm.Migrate(migrations[0], changed)
+			Convey("Then nothing should be applied", func() {
+				So(errors.Is(err, gremerror.ErrMigrationChanged), ShouldBeTrue)
+				So(applied, ShouldBeEmpty)
+				for _, q := range sent {
+					So(q, ShouldNotContainSubstring, "g.addV")
+				}
+			})
+		})
+
+		Convey("When Migrate is called with a description holding quotes and dollar signs", func() {
+			quoted := migrations[0]
+			quoted.Description = `say "hi" ${x}`
+			_, err := sm.Migrate(quoted)
+			Convey("Then the description should only be sent as a binding", func() {
+				So(err, ShouldBeNil)
+				So(sent[len(sent)-1], ShouldNotContainSubstring, "hi")
+				So(bindings[len(bindings)-1]["b0"], ShouldEqual, `say "hi" ${x}`)
+			})
+		})
+
+		Convey("When Migrate is called after only the description changed", func() {
+			changed := migrations[1]
+			changed.Description = "property key for names"
+			_, err := sm.Migrate(changed)
+			Convey("Then the migration should still be applied", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a string executor that fails and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		sm.executeBoundStringQuery = func(string, map[string]string, map[string]string) ([][]byte, error) {
+			return nil, errors.New("ERROR")
+		}
+		Convey("When Migrate is called", func() {
+			_, err := sm.Migrate(migrations...)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a string executor that fails to record and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, nil }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		sm.executeBoundStringQuery = func(q string, _, _ map[string]string) ([][]byte, error) {
+			if strings.Contains(q, "g.addV") {
+				return nil, errors.New("ERROR")
+			}
+			return nil, nil
+		}
+		Convey("When Migrate is called", func() {
+			applied, err := sm.Migrate(migrations...)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(applied, ShouldBeEmpty)
+			})
+		})
+	})
+	Convey("Given a migration another runner records first", t, func() {
+		var sent []string
+		execute := func(string) ([][]byte, error) { return nil, nil }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		sm.executeBoundStringQuery = func(q string, _, _ map[string]string) ([][]byte, error) {
+			sent = append(sent, q)
+			// The server runs the guard and finds the other runner's record.
+			if strings.Contains(q, ".hasNext()) {") && strings.Contains(q, "g.addV") {
+				return nil, gremerror.NewNetworkError(597, "SCRIPT EVALUATION ERROR")
+			}
+			return nil, nil
+		}
+		Convey("When Migrate is called", func() {
+			applied, err := sm.Migrate(migrations[1])
+			Convey("Then the guarded script should fail without recording the migration", func() {
+				So(err, ShouldNotBeNil)
+				So(applied, ShouldBeEmpty)
+				So(sent[len(sent)-1], ShouldContainSubstring, `has("migrationVersion",1L).hasNext()`)
+			})
+		})
+	})
+}
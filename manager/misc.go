@@ -32,6 +32,7 @@ import (
 type miscQueryManager struct {
 	logger             logging.Logger
 	executeStringQuery stringExecutor
+	schema             *propertySchema
 }
 
 func newMiscQueryManager(logger logging.Logger, execute stringExecutor) *miscQueryManager {
@@ -54,6 +55,11 @@ func (m *miscQueryManager) SetVertexProperty(id int64, keyAndVals ...interface{}
 		return gremerror.ErrOddNumberOfParameters
 	}
 
+	if err := m.schema.validate(keyAndVals...); err != nil {
+		m.logger.Error("invalid property", err)
+		return err
+	}
+
 	query := traversal.NewTraversal().V().HasID(id)
 	for i := 0; i < len(keyAndVals); i += 2 {
 		query.AddStep("property", keyAndVals[i], keyAndVals[i+1])
@@ -73,12 +79,15 @@ func (m *miscQueryManager) SetVertexProperty(id int64, keyAndVals ...interface{}
 // that are currently on the graph as an int64.
 func (m *miscQueryManager) VertexCount() (int64, error) {
 	// Query the graph for the count using IDs.
-	query := traversal.NewTraversal().V().Count()
+	return count(m.logger, m.executeStringQuery, "VertexCount", traversal.NewTraversal().V().Count())
+}
 
-	responses, err := m.executeStringQuery(query.String())
+// count executes the counting query and returns the count.
+func count(logger logging.Logger, execute stringExecutor, function string, query traversal.String) (int64, error) {
+	responses, err := execute(query.String())
 	if err != nil {
-		m.logger.Error("VertexCount",
-			gremerror.NewQueryError("VertexCount", query.String(), err),
+		logger.Error(function,
+			gremerror.NewQueryError(function, query.String(), err),
 		)
 		return 0, err
 	}
@@ -90,8 +99,8 @@ func (m *miscQueryManager) VertexCount() (int64, error) {
 
 		err = jsonUnmarshal(res, &rawIDs)
 		if err != nil {
-			m.logger.Error("id unmarshal",
-				gremerror.NewUnmarshalError("VertexCount", res, err),
+			logger.Error("id unmarshal",
+				gremerror.NewUnmarshalError(function, res, err),
 			)
 			return 0, err
 		}
@@ -22,13 +22,20 @@ package manager
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
 	"github.com/northwesternmutual/grammes/query"
 	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/computer"
+	"github.com/northwesternmutual/grammes/query/consistency"
 	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/index"
 	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/order"
 )
 
 var (
@@ -74,6 +81,8 @@ type MiscQuerier interface {
 	VertexCount() (count int64, err error)
 	// SetVertexProperty will either add or set the property of a vertex.
 	SetVertexProperty(id int64, keyAndVals ...interface{}) error
+	// ComputerQuery will run a traversal as an OLAP job and unmarshal its results.
+	ComputerQuery(queryObj query.Query, c computer.Computer, v interface{}) error
 }
 
 // SchemaQuerier handles all schema related queries to the graph.
@@ -84,8 +93,57 @@ type SchemaQuerier interface {
 	AddEdgeLabels(multiplicityAndLabels ...interface{}) (ids []int64, err error)
 	// AddPropertyKey adds a new property key to the schema.
 	AddPropertyKey(label string, dt datatype.DataType, card cardinality.Cardinality) (id int64, err error)
+	// AddVertexLabel adds a new vertex label to the schema.
+	AddVertexLabel(label string, partitioned, static bool) error
+	// SetTTL makes the elements or values of the schema type expire.
+	SetTTL(t graph.SchemaType, ttl time.Duration) error
+	// SetConsistency sets how the schema type is kept consistent.
+	SetConsistency(t graph.SchemaType, modifier consistency.Modifier) error
+	// AddProperties allows the property keys on the label.
+	AddProperties(label graph.SchemaType, keys ...string) error
+	// AddConnection allows the edge label between the vertex labels.
+	AddConnection(label, outLabel, inLabel string) error
+	// ChangeName renames the schema type.
+	ChangeName(t graph.SchemaType, name string) error
 	// CommitSchema will finalize your changes and apply them to the schema.
 	CommitSchema() (res [][]byte, err error)
+	// ExecuteManagement runs the statements in a single management transaction.
+	ExecuteManagement(statements ...graph.String) (res [][]byte, err error)
+	// AddCompositeIndex builds a composite index of the property keys.
+	AddCompositeIndex(name string, element index.Element, unique bool, keys ...string) error
+	// AddMixedIndex builds a mixed index of the property keys in the indexing backend.
+	AddMixedIndex(name string, element index.Element, backend string, keys ...index.Key) error
+	// AddEdgeIndex builds a vertex-centric index for the edges with the label.
+	AddEdgeIndex(label, name string, dir direction.Direction, sort order.Order, keys ...string) error
+	// UpdateIndex applies the action to the graph index.
+	UpdateIndex(name string, action index.Action) error
+	// UpdateEdgeIndex applies the action to the vertex-centric index.
+	UpdateEdgeIndex(label, name string, action index.Action) error
+	// AwaitGraphIndexStatus waits until the graph index reaches the status.
+	AwaitGraphIndexStatus(name string, status index.Status, timeout ...time.Duration) error
+	// AwaitEdgeIndexStatus waits until the vertex-centric index reaches the status.
+	AwaitEdgeIndexStatus(label, name string, status index.Status, timeout ...time.Duration) error
+	// PropertyKeys returns the property keys in the schema.
+	PropertyKeys() (keys []model.PropertyKey, err error)
+	// EdgeLabels returns the edge labels in the schema.
+	EdgeLabels() (labels []model.EdgeLabel, err error)
+	// VertexLabels returns the vertex labels in the schema.
+	VertexLabels() (labels []model.VertexLabel, err error)
+	// Indexes returns the graph and vertex-centric indexes in the schema.
+	Indexes() (indexes []model.Index, err error)
+	// Connections returns the connections between vertex labels in the schema.
+	Connections() (connections []model.Connection, err error)
+	// Schema returns every schema type in the graph.
+	Schema() (schema model.Schema, err error)
+	// DiffSchema compares a schema definition to the graph's schema.
+	DiffSchema(definition model.Schema) (diff model.SchemaDiff, err error)
+	// ApplySchema creates the missing schema types in one management transaction.
+	ApplySchema(definition model.Schema) (diff model.SchemaDiff, err error)
+	// AppliedMigrations returns the records of the applied migrations.
+	AppliedMigrations() (applied []model.AppliedMigration, err error)
+	// Migrate applies the migrations that haven't been applied yet.
+	// It should be run from a single process at a time.
+	Migrate(migrations ...model.Migration) (applied []model.AppliedMigration, err error)
 }
 
 // GetVertexQuerier are functions specifically related to getting vertices.
@@ -126,6 +184,12 @@ type AddVertexQuerier interface {
 	AddVertexByStruct(vertexStruct model.Vertex) (vertex model.Vertex, err error)
 	// AddVertex adds a vertex to the graph with label and properties provided.
 	AddVertex(label string, properties ...interface{}) (vertex model.Vertex, err error)
+	// MergeVertex finds the vertex with the label and properties or creates it.
+	MergeVertex(label string, match, onCreate, onMatch map[string]interface{}) (vertex model.Vertex, err error)
+	// UpsertVertex finds the vertex with the label and properties or creates it, then sets properties on it.
+	UpsertVertex(label string, match, set map[string]interface{}) (vertex model.Vertex, created bool, err error)
+	// UpsertVertices upserts the vertices in a single query.
+	UpsertVertices(upserts ...model.VertexUpsert) (upserted []model.UpsertedVertex, err error)
 }
 
 // DropQuerier has functions related to dropping vertices from the graph.
@@ -140,6 +204,41 @@ type DropQuerier interface {
 	DropVerticesByQuery(queryObj query.Query) error
 }
 
+// EdgeQuerier handles the edges on the graph.
+type EdgeQuerier interface {
+	// AddEdge adds an edge between the vertices with the label and properties provided.
+	AddEdge(outID, inID int64, label string, properties ...interface{}) (edge model.Edge, err error)
+	// EdgeByID will return a single edge based on the ID provided.
+	EdgeByID(id string) (edge model.Edge, err error)
+	// EdgesByString will return already unmarshalled edge structs from a string query.
+	EdgesByString(stringQuery string) (edges []model.Edge, err error)
+	// EdgesByQuery will return already unmarshalled edge structs from a query object.
+	EdgesByQuery(queryObj query.Query) (edges []model.Edge, err error)
+	// Edges will return edges based on the label and properties.
+	Edges(label string, properties ...interface{}) (edges []model.Edge, err error)
+	// DropEdge drops edges based on their IDs.
+	DropEdge(ids ...string) error
+	// SetEdgeProperty will either add or set the properties of an edge.
+	SetEdgeProperty(id string, keyAndVals ...interface{}) error
+	// EdgeCount will return the number of edges on the graph.
+	EdgeCount() (count int64, err error)
+	// UpsertEdge finds the edge between the vertices or creates it, then sets properties on it.
+	UpsertEdge(outID, inID int64, label string, properties map[string]interface{}) (edge model.Edge, created bool, err error)
+	// UpsertEdges upserts the edges in a single query.
+	UpsertEdges(upserts ...model.EdgeUpsert) (upserted []model.UpsertedEdge, err error)
+}
+
+// BulkQuerier handles operations on many elements at a time.
+type BulkQuerier interface {
+	// BulkLoad adds the vertices and the edges between them in concurrent batches.
+	// It isn't idempotent, so reloading failed elements may duplicate added ones.
+	BulkLoad(vertices []model.BulkVertex, edges []model.BulkEdge, options ...BatchOption) (result model.BulkResult, err error)
+	// DropAllInBatches drops every vertex on the graph in concurrent batches.
+	DropAllInBatches(options ...BatchOption) error
+	// DropVertexLabelInBatches drops the vertices with the label in concurrent batches.
+	DropVertexLabelInBatches(label string, options ...BatchOption) error
+}
+
 // ExecuteQuerier handles the raw queries to the server.
 type ExecuteQuerier interface {
 	// ExecuteQuery will execute a query object and return its raw result.
@@ -164,6 +263,8 @@ type VertexQuerier interface {
 type GraphManager interface {
 	MiscQuerier
 	VertexQuerier
+	EdgeQuerier
+	BulkQuerier
 	ExecuteQuerier
 	SchemaQuerier
 
@@ -179,6 +280,10 @@ type GraphManager interface {
 	DropQuerier() DropQuerier
 	// Returns the interface and functions associated with the VertexQuerier.
 	VertexQuerier() VertexQuerier
+	// Returns the interface and functions associated with the EdgeQuerier.
+	EdgeQuerier() EdgeQuerier
+	// Returns the interface and functions associated with the BulkQuerier.
+	BulkQuerier() BulkQuerier
 	// Returns the interface and functions associated with the ExecuteQuerier.
 	ExecuteQuerier() ExecuteQuerier
 	// Returns the interface and functions associated with the SchemaQuerier.
@@ -186,4 +291,6 @@ type GraphManager interface {
 
 	// Sets the logging object used by the GraphManager.
 	SetLogger(logging.Logger)
+	// ValidateProperties checks property values against the schema.
+	ValidateProperties(keyAndVals ...interface{}) error
 }
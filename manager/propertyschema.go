@@ -0,0 +1,136 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"sync"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// propertySchema remembers the property keys of the schema
+// so property values can be checked before they're sent.
+// The keys are read the first time they're needed and read
+// again after the schema is changed through the manager.
+type propertySchema struct {
+	mu      sync.Mutex
+	enabled bool
+	keys    map[string]model.PropertyKey
+	read    func() ([]model.PropertyKey, error)
+}
+
+func newPropertySchema(read func() ([]model.PropertyKey, error)) *propertySchema {
+	return &propertySchema{
+		read: read,
+	}
+}
+
+// setEnabled turns the validation on or off
+// and forgets the property keys read so far.
+func (p *propertySchema) setEnabled(enabled bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.enabled = enabled
+	p.keys = nil
+}
+
+// reset forgets the property keys so
+// they're read again when next needed.
+func (p *propertySchema) reset() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keys = nil
+}
+
+// validate checks the values of the keys against their
+// property keys when validation is enabled. Keys that
+// aren't in the schema, and keys that aren't strings
+// such as tokens, are left to the server.
+func (p *propertySchema) validate(keyAndVals ...interface{}) error {
+	if p == nil {
+		return nil
+	}
+
+	keys, err := p.propertyKeys()
+	if err != nil || keys == nil {
+		return err
+	}
+
+	var (
+		names  []string
+		values = make(map[string][]interface{})
+	)
+
+	for i := 0; i+1 < len(keyAndVals); i += 2 {
+		name, ok := keyAndVals[i].(string)
+		if !ok {
+			continue
+		}
+
+		if _, ok = values[name]; !ok {
+			names = append(names, name)
+		}
+		values[name] = append(values[name], keyAndVals[i+1])
+	}
+
+	for _, name := range names {
+		if key, ok := keys[name]; ok {
+			if err = key.Validate(values[name]...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// propertyKeys returns the property keys by name,
+// or nil when validation isn't enabled.
+func (p *propertySchema) propertyKeys() (map[string]model.PropertyKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.enabled {
+		return nil, nil
+	}
+
+	if p.keys != nil {
+		return p.keys, nil
+	}
+
+	list, err := p.read()
+	if err != nil {
+		return nil, gremerror.NewGrammesError("ValidateProperties", err)
+	}
+
+	p.keys = make(map[string]model.PropertyKey, len(list))
+	for _, k := range list {
+		p.keys[k.Name] = k
+	}
+
+	return p.keys, nil
+}
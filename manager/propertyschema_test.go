@@ -0,0 +1,147 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremconnect"
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+func TestPropertySchemaValidate(t *testing.T) {
+	Convey("Given a property schema", t, func() {
+		var reads int
+		p := newPropertySchema(func() ([]model.PropertyKey, error) {
+			reads++
+			return []model.PropertyKey{
+				{Name: "age", DataType: datatype.Long, Cardinality: cardinality.Single},
+				{Name: "nickname", DataType: datatype.String, Cardinality: cardinality.List},
+			}, nil
+		})
+		Convey("When validate is called while it's disabled", func() {
+			err := p.validate("age", "twelve")
+			Convey("Then nothing should be checked or read", func() {
+				So(err, ShouldBeNil)
+				So(reads, ShouldEqual, 0)
+			})
+		})
+		Convey("When validate is called while it's enabled", func() {
+			p.setEnabled(true)
+			Convey("Then valid values should be allowed", func() {
+				So(p.validate("age", 12, "nickname", "a", "nickname", "b"), ShouldBeNil)
+				So(p.validate("unknown", "x"), ShouldBeNil)
+			})
+			Convey("Then a value of the wrong type should be rejected", func() {
+				So(p.validate("age", "twelve"), ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+			})
+			Convey("Then multiple values for a SINGLE key should be rejected", func() {
+				So(p.validate("age", 1, "age", 2), ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+			})
+			Convey("Then the keys should only be read again after a reset", func() {
+				So(p.validate("age", 1), ShouldBeNil)
+				So(p.validate("age", 2), ShouldBeNil)
+				So(reads, ShouldEqual, 1)
+				p.reset()
+				So(p.validate("age", 3), ShouldBeNil)
+				So(reads, ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given a property schema that can't be read", t, func() {
+		p := newPropertySchema(func() ([]model.PropertyKey, error) {
+			return nil, errors.New("ERROR")
+		})
+		p.setEnabled(true)
+		Convey("When validate is called", func() {
+			err := p.validate("age", 1)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a nil property schema", t, func() {
+		var p *propertySchema
+		Convey("Then nothing should be checked", func() {
+			p.reset()
+			So(p.validate("age", "twelve"), ShouldBeNil)
+		})
+	})
+}
+
+func TestSchemaValidation(t *testing.T) {
+	Convey("Given a graph manager with schema validation", t, func() {
+		var queries []string
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(q string, _, _ map[string]string) ([][]byte, error) {
+			queries = append(queries, q)
+			if r, ok := schemaResponses[q]; ok {
+				return [][]byte{[]byte(r)}, nil
+			}
+			return [][]byte{[]byte(vertexResponse)}, nil
+		}
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		gm.SetSchemaValidation(true)
+		Convey("When AddVertex is called with a string for an Integer key", func() {
+			_, err := gm.AddVertexQuerier().AddVertex("person", "age", "twelve")
+			Convey("Then a property error should be returned before the vertex is added", func() {
+				So(err, ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+				So(queries, ShouldResemble, []string{propertyKeysScript})
+			})
+		})
+		Convey("When SetVertexProperty is called with two values for a SINGLE key", func() {
+			err := gm.MiscQuerier().SetVertexProperty(1, "age", 1, "age", 2)
+			Convey("Then a property error should be returned", func() {
+				So(err, ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+			})
+		})
+		Convey("When ValidateProperties is called with a valid value", func() {
+			err := gm.ValidateProperties("age", 12)
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+		Convey("When the schema is changed through the manager", func() {
+			So(gm.ValidateProperties("age", 12), ShouldBeNil)
+			_, err := gm.ExecuteManagement(graph.String("mgmt.makePropertyKey(\"x\").make()"))
+			So(err, ShouldBeNil)
+			So(gm.ValidateProperties("age", 12), ShouldBeNil)
+			Convey("Then the property keys should be read again", func() {
+				var reads int
+				for _, q := range queries {
+					if q == propertyKeysScript {
+						reads++
+					}
+				}
+				So(reads, ShouldEqual, 2)
+			})
+		})
+	})
+}
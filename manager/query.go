@@ -21,10 +21,14 @@
 package manager
 
 import (
+	"strings"
+
 	"github.com/northwesternmutual/grammes/gremconnect"
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/dialect"
+	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
 // Query handles the querying actions to the server.
@@ -32,6 +36,11 @@ type queryManager struct {
 	dialer         gremconnect.Dialer
 	logger         logging.Logger
 	executeRequest executor
+	// strict determines whether traversals are
+	// validated before they are sent to the server.
+	strict bool
+	// dialect is the provider that queries are rendered for.
+	dialect dialect.Dialect
 }
 
 // NewQueryManager returns a new Query Manager that
@@ -77,8 +86,81 @@ func (m *queryManager) ExecuteBoundStringQuery(query string, bindings, rebinding
 		return nil, gremerror.ErrDisposedConnection
 	}
 
+	if m.strict {
+		parseErr, err := validateQuery(query)
+		if err != nil {
+			m.logger.Error("invalid query",
+				gremerror.NewQueryError("ExecuteBoundStringQuery", query, err),
+			)
+			return nil, err
+		}
+		if parseErr != nil {
+			m.logger.Debug("query not validated", map[string]interface{}{
+				"query": query,
+				"error": parseErr.Error(),
+			})
+		}
+	}
+
+	query, err := renderQuery(query, m.dialect)
+	if err != nil {
+		m.logger.Error("query not supported by dialect",
+			gremerror.NewQueryError("ExecuteBoundStringQuery", query, err),
+		)
+		return nil, err
+	}
+
 	// log the command that will be executed.
 	m.logger.PrintQuery(query)
 
 	return m.executeRequest(query, bindings, rebindings)
 }
+
+// validateQuery checks traversals started from the "g" source against
+// TinkerPop's grammar and returns the error that was found. Other
+// scripts, such as schema management scripts, aren't checked. A
+// traversal the parser doesn't understand, such as a script with
+// several statements, can't be checked either so it is reported
+// by the parse error instead of being rejected.
+func validateQuery(query string) (parseErr, err error) {
+	script, err := traversal.Parse(query)
+	if err != nil {
+		if query = strings.TrimSpace(query); query == "g" || strings.HasPrefix(query, "g.") {
+			return err, nil
+		}
+		return nil, nil
+	}
+
+	return nil, script.Validate()
+}
+
+// renderQuery adapts the query to the provider's dialect. Scripts that
+// cannot be parsed are sent as they are unless they use the graph
+// object on a provider that doesn't have one. A script is only
+// rewritten when the dialect changes it, such as its numeric IDs,
+// so anything else is sent exactly as it was written.
+func renderQuery(query string, d dialect.Dialect) (string, error) {
+	if d == dialect.Generic {
+		return query, nil
+	}
+
+	script, err := traversal.Parse(query)
+	if err != nil {
+		if !d.SupportsManagement() && strings.Contains(query, "graph.") {
+			return query, gremerror.NewValidationError("", 0,
+				"the graph object is not supported by "+d.Name())
+		}
+		return query, nil
+	}
+
+	rendered, err := script.Render(d)
+	if err != nil {
+		return query, err
+	}
+
+	if rendered.String() == script.String() {
+		return query, nil
+	}
+
+	return rendered.String(), nil
+}
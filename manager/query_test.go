@@ -26,6 +26,7 @@ import (
 
 	"github.com/northwesternmutual/grammes/gremconnect"
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/dialect"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -120,3 +121,129 @@ func TestExecuteBoundStringQueryDisposedConnection(t *testing.T) {
 		})
 	})
 }
+
+func TestExecuteBoundStringQueryStrict(t *testing.T) {
+	Convey("Given a dialer, string executor and strict query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var sent []string
+		execute := func(q string, _ map[string]string, _ map[string]string) ([][]byte, error) {
+			sent = append(sent, q)
+			return nil, nil
+		}
+		qm := newQueryManager(dialer, logging.NewNilLogger(), execute)
+		qm.strict = true
+		Convey("When ExecuteStringQuery is called with a valid traversal", func() {
+			_, err := qm.ExecuteStringQuery("g.V().hasLabel(\"person\").order().by(\"name\")")
+			Convey("Then the query should be sent", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with an invalid traversal", func() {
+			_, err := qm.ExecuteStringQuery("g.V().out().by(\"name\")")
+			Convey("Then the error should be returned without sending the query", func() {
+				So(err, ShouldNotBeNil)
+				So(sent, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with a traversal that cannot be parsed", func() {
+			_, err := qm.ExecuteStringQuery("g.V().drop().iterate(); g.V().count()")
+			Convey("Then the query should be sent for the server to check", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with exponents, closures and a property map", func() {
+			_, err := qm.ExecuteStringQuery("g.V().has(\"w\",gt(1e-5)).map{ it.get() }.property([name:\"x\"])")
+			Convey("Then the query should be sent", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with a script that isn't a traversal", func() {
+			_, err := qm.ExecuteStringQuery("mgmt = graph.openManagement(); mgmt.commit()")
+			Convey("Then the query should be sent", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func TestExecuteBoundStringQueryDialectLambda(t *testing.T) {
+	Convey("Given a query manager for JanusGraph", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var sent []string
+		execute := func(q string, _ map[string]string, _ map[string]string) ([][]byte, error) {
+			sent = append(sent, q)
+			return nil, nil
+		}
+		qm := newQueryManager(dialer, logging.NewNilLogger(), execute)
+		qm.dialect = dialect.JanusGraph
+		Convey("When ExecuteStringQuery is called with a lambda", func() {
+			query := "g.V().sideEffect{ println it }"
+			_, err := qm.ExecuteStringQuery(query)
+			Convey("Then the lambda should be sent exactly as it was written", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldResemble, []string{query})
+			})
+		})
+	})
+}
+
+func TestExecuteBoundStringQueryDialect(t *testing.T) {
+	Convey("Given a dialer, string executor and query manager for Neptune", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var sent []string
+		execute := func(q string, _ map[string]string, _ map[string]string) ([][]byte, error) {
+			sent = append(sent, q)
+			return nil, nil
+		}
+		qm := newQueryManager(dialer, logging.NewNilLogger(), execute)
+		qm.dialect = dialect.Neptune
+		Convey("When ExecuteStringQuery is called with numeric IDs", func() {
+			_, err := qm.ExecuteStringQuery("g.V(1).out()")
+			Convey("Then the rendered query should be sent", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldResemble, []string{"g.V(\"1\").out()"})
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with a query the dialect doesn't change", func() {
+			query := `g.V().has('name', 'marko').order().by("age", desc)`
+			_, err := qm.ExecuteStringQuery(query)
+			Convey("Then the query should be sent exactly as it was written", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldResemble, []string{query})
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with a management query", func() {
+			_, err := qm.ExecuteStringQuery("graph.openManagement().makeVertexLabel(\"person\").make()")
+			Convey("Then the error should be returned without sending the query", func() {
+				So(err, ShouldNotBeNil)
+				So(sent, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with a management script", func() {
+			_, err := qm.ExecuteStringQuery("mgmt = graph.openManagement(); mgmt.commit()")
+			Convey("Then the error should be returned without sending the query", func() {
+				So(err, ShouldNotBeNil)
+				So(sent, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When ExecuteStringQuery is called with an unsupported step", func() {
+			_, err := qm.ExecuteStringQuery("g.V().pageRank()")
+			Convey("Then the error should be returned without sending the query", func() {
+				So(err, ShouldNotBeNil)
+				So(sent, ShouldBeEmpty)
+			})
+		})
+	})
+}
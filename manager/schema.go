@@ -32,8 +32,10 @@ import (
 )
 
 type schemaManager struct {
-	logger             logging.Logger
-	executeStringQuery stringExecutor
+	logger                  logging.Logger
+	executeStringQuery      stringExecutor
+	executeBoundStringQuery executor
+	schema                  *propertySchema
 }
 
 func newSchemaManager(logger logging.Logger, executor stringExecutor) *schemaManager {
@@ -124,6 +126,7 @@ func (s *schemaManager) AddPropertyKey(propertyName string, datatype datatype.Da
 		)
 		return
 	}
+	s.schema.reset()
 	if id, err = unmarshalID(data); err != nil {
 		s.logger.Error("id unmarshal",
 			gremerror.NewUnmarshalError("AddPropertyKey", data[0], err),
@@ -143,6 +146,7 @@ func (s *schemaManager) CommitSchema() ([][]byte, error) {
 		)
 		return nil, err
 	}
+	s.schema.reset()
 
 	return data, nil
 }
@@ -0,0 +1,170 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+// Schema reads every schema type that exists in the graph.
+func (s *schemaManager) Schema() (model.Schema, error) {
+	var (
+		existing model.Schema
+		err      error
+	)
+
+	if existing.PropertyKeys, err = s.PropertyKeys(); err != nil {
+		return model.Schema{}, err
+	}
+	if existing.VertexLabels, err = s.VertexLabels(); err != nil {
+		return model.Schema{}, err
+	}
+	if existing.EdgeLabels, err = s.EdgeLabels(); err != nil {
+		return model.Schema{}, err
+	}
+	if existing.Connections, err = s.Connections(); err != nil {
+		return model.Schema{}, err
+	}
+	if existing.Indexes, err = s.Indexes(); err != nil {
+		return model.Schema{}, err
+	}
+
+	return existing, nil
+}
+
+// DiffSchema compares the schema definition
+// to the schema that exists in the graph.
+func (s *schemaManager) DiffSchema(definition model.Schema) (model.SchemaDiff, error) {
+	existing, err := s.Schema()
+	if err != nil {
+		return model.SchemaDiff{}, err
+	}
+
+	return definition.Diff(existing), nil
+}
+
+// ApplySchema creates the schema types that are defined but
+// missing from the graph in a single management transaction.
+// Nothing is changed when the definition conflicts with the
+// graph, and nothing is sent when the graph already matches,
+// so the same definition can be applied any number of times.
+// The returned diff holds what was created or the conflicts.
+func (s *schemaManager) ApplySchema(definition model.Schema) (model.SchemaDiff, error) {
+	diff, err := s.DiffSchema(definition)
+	if err != nil {
+		return diff, err
+	}
+
+	if err = diff.Err(); err != nil {
+		s.logger.Error("schema conflicts", err)
+		return diff, err
+	}
+
+	if diff.Empty() {
+		return diff, nil
+	}
+
+	if _, err = s.ExecuteManagement(schemaStatements(diff.Missing)...); err != nil {
+		return diff, err
+	}
+
+	s.logger.Debug("Applied Schema", map[string]interface{}{
+		"PropertyKeys": len(diff.Missing.PropertyKeys),
+		"VertexLabels": len(diff.Missing.VertexLabels),
+		"EdgeLabels":   len(diff.Missing.EdgeLabels),
+		"Connections":  len(diff.Missing.Connections),
+		"Indexes":      len(diff.Missing.Indexes),
+	})
+
+	return diff, nil
+}
+
+// schemaStatements creates the schema types in the order
+// they depend on each other: keys and labels first, then
+// the connections and indexes that look them up.
+func schemaStatements(m model.Schema) []graph.String {
+	var statements []graph.String
+
+	for _, k := range m.PropertyKeys {
+		statements = append(statements, graph.NewManagement().
+			MakePropertyKey(k.Name, k.DataType, k.Cardinality).Make())
+	}
+
+	for _, l := range m.VertexLabels {
+		query := graph.NewManagement().MakeVertexLabel(l.Name)
+		if l.Partitioned {
+			query = query.Partition()
+		}
+		if l.Static {
+			query = query.SetStatic()
+		}
+		statements = append(statements, query.Make())
+	}
+
+	for _, l := range m.EdgeLabels {
+		query := graph.NewManagement().MakeEdgeLabel(l.Name).Multiplicity(l.Multiplicity)
+		if l.Unidirected {
+			query = query.Unidirected()
+		}
+		statements = append(statements, query.Make())
+	}
+
+	for _, c := range m.Connections {
+		statements = append(statements, graph.NewManagement().
+			AddConnection(c.EdgeLabel, c.OutVertexLabel, c.InVertexLabel))
+	}
+
+	for _, i := range m.Indexes {
+		statements = append(statements, indexStatement(i))
+	}
+
+	return statements
+}
+
+func indexStatement(i model.Index) graph.String {
+	if i.Type == model.EdgeIndex {
+		keys := make([]string, 0, len(i.Keys))
+		for _, k := range i.Keys {
+			keys = append(keys, k.Name)
+		}
+		return graph.NewManagement().BuildEdgeIndex(i.Label, i.Name, i.Direction, i.Order, keys...)
+	}
+
+	query := graph.NewManagement().BuildIndex(i.Name, i.Element)
+	for _, k := range i.Keys {
+		if k.Mapping != "" {
+			query = query.AddKey(k.Name, k.Mapping)
+		} else {
+			query = query.AddKey(k.Name)
+		}
+	}
+
+	if i.Type == model.MixedIndex {
+		return query.BuildMixedIndex(i.Backend)
+	}
+
+	if i.Unique {
+		query = query.Unique()
+	}
+
+	return query.BuildCompositeIndex()
+}
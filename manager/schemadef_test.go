@@ -0,0 +1,146 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+var schemaDefinition = model.Schema{
+	PropertyKeys: []model.PropertyKey{{Name: "name", DataType: datatype.String}, {Name: "time", DataType: datatype.Long}},
+	VertexLabels: []model.VertexLabel{{Name: "person", Partitioned: true, Static: true}},
+	EdgeLabels:   []model.EdgeLabel{{Name: "knows", Unidirected: true}},
+	Connections:  []model.Connection{{EdgeLabel: "knows", OutVertexLabel: "person", InVertexLabel: "person"}},
+	Indexes: []model.Index{
+		{Name: "byName", Element: index.Vertex, Unique: true, Keys: []model.IndexKey{{Name: "name"}}},
+		{Name: "search", Element: index.Vertex, Backend: "search", Keys: []model.IndexKey{{Name: "name", Mapping: index.TextString}}},
+		{Name: "byTime", Type: model.EdgeIndex, Label: "knows", Direction: direction.Out, Order: order.Desc, Keys: []model.IndexKey{{Name: "time"}}},
+	},
+}
+
+func TestApplySchema(t *testing.T) {
+	Convey("Given a string executor for an empty graph and schema manager", t, func() {
+		var sent []string
+		execute := func(q string) ([][]byte, error) {
+			sent = append(sent, q)
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When ApplySchema is called", func() {
+			diff, err := sm.ApplySchema(schemaDefinition)
+			Convey("Then every type should be created in one transaction", func() {
+				So(err, ShouldBeNil)
+				So(diff.Missing.Indexes, ShouldHaveLength, 3)
+				So(sent, ShouldHaveLength, 6)
+				So(sent[5], ShouldEqual, "mgmt = graph.openManagement();"+
+					"mgmt.makePropertyKey(\"name\").dataType(String.class).cardinality(SINGLE).make();"+
+					"mgmt.makePropertyKey(\"time\").dataType(Long.class).cardinality(SINGLE).make();"+
+					"mgmt.makeVertexLabel(\"person\").partition().setStatic().make();"+
+					"mgmt.makeEdgeLabel(\"knows\").multiplicity(MULTI).unidirected().make();"+
+					"mgmt.addConnection(mgmt.getEdgeLabel(\"knows\"),mgmt.getVertexLabel(\"person\"),mgmt.getVertexLabel(\"person\"));"+
+					"mgmt.buildIndex(\"byName\",Vertex.class).addKey(mgmt.getPropertyKey(\"name\")).unique().buildCompositeIndex();"+
+					"mgmt.buildIndex(\"search\",Vertex.class).addKey(mgmt.getPropertyKey(\"name\"),Mapping.TEXTSTRING.asParameter()).buildMixedIndex(\"search\");"+
+					"mgmt.buildEdgeIndex(mgmt.getEdgeLabel(\"knows\"),\"byTime\",OUT,Order.desc,mgmt.getPropertyKey(\"time\"));"+
+					"mgmt.commit()")
+			})
+		})
+
+		Convey("When ApplySchema is called with an edge index without a direction or order", func() {
+			_, err := sm.ApplySchema(model.Schema{Indexes: []model.Index{
+				{Name: "byTime", Label: "knows", Keys: []model.IndexKey{{Name: "time"}}},
+			}})
+			Convey("Then it should be built in both directions in ascending order", func() {
+				So(err, ShouldBeNil)
+				So(sent[len(sent)-1], ShouldEqual, "mgmt = graph.openManagement();"+
+					"mgmt.buildEdgeIndex(mgmt.getEdgeLabel(\"knows\"),\"byTime\",BOTH,Order.asc,mgmt.getPropertyKey(\"time\"));"+
+					"mgmt.commit()")
+			})
+		})
+	})
+
+	Convey("Given a string executor for a graph that matches and schema manager", t, func() {
+		var sent []string
+		execute := func(q string) ([][]byte, error) {
+			sent = append(sent, q)
+			if q == propertyKeysScript {
+				return [][]byte{[]byte(`[{"name":"name","dataType":"Integer.class","cardinality":"SINGLE"}]`)}, nil
+			}
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When ApplySchema is called with a conflicting definition", func() {
+			diff, err := sm.ApplySchema(schemaDefinition)
+			Convey("Then nothing should be created", func() {
+				So(errors.Is(err, gremerror.ErrSchemaConflict), ShouldBeTrue)
+				So(diff.Conflicts, ShouldHaveLength, 1)
+				So(sent, ShouldHaveLength, 5)
+			})
+		})
+
+		Convey("When ApplySchema is called with a definition that already exists", func() {
+			diff, err := sm.ApplySchema(model.Schema{PropertyKeys: []model.PropertyKey{{Name: "name", DataType: datatype.Integer}}})
+			Convey("Then nothing should be sent", func() {
+				So(err, ShouldBeNil)
+				So(diff.Empty(), ShouldBeTrue)
+				So(sent, ShouldHaveLength, 5)
+			})
+		})
+	})
+
+	Convey("Given a string executor that fails and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When ApplySchema is called", func() {
+			_, err := sm.ApplySchema(schemaDefinition)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a string executor that fails to apply and schema manager", t, func() {
+		execute := func(q string) ([][]byte, error) {
+			if q == propertyKeysScript || q == vertexLabelsScript || q == edgeLabelsScript ||
+				q == connectionsScript || q == indexesScript {
+				return nil, nil
+			}
+			return nil, errors.New("ERROR")
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When ApplySchema is called", func() {
+			_, err := sm.ApplySchema(schemaDefinition)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
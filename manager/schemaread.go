@@ -0,0 +1,124 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// These scripts read the schema in a management transaction
+// that is rolled back, and return each schema type as a map
+// which matches the struct it is unmarshalled into.
+const (
+	propertyKeysScript = "mgmt = graph.openManagement();" +
+		"r = mgmt.getRelationTypes(PropertyKey.class).collect{ k -> ['name': k.name(), " +
+		"'dataType': k.dataType().getSimpleName() + '.class', 'cardinality': k.cardinality().name()] };" +
+		"mgmt.rollback();r"
+
+	edgeLabelsScript = "mgmt = graph.openManagement();" +
+		"r = mgmt.getRelationTypes(EdgeLabel.class).collect{ l -> ['name': l.name(), " +
+		"'multiplicity': l.multiplicity().name(), 'directed': l.isDirected(), 'unidirected': l.isUnidirected()] };" +
+		"mgmt.rollback();r"
+
+	vertexLabelsScript = "mgmt = graph.openManagement();" +
+		"r = mgmt.getVertexLabels().collect{ l -> ['name': l.name(), " +
+		"'partitioned': l.isPartitioned(), 'static': l.isStatic()] };" +
+		"mgmt.rollback();r"
+
+	indexesScript = "mgmt = graph.openManagement();" +
+		"graphIndex = { i, e -> ['name': i.name(), 'type': i.isCompositeIndex() ? 'composite' : 'mixed', " +
+		"'element': e, 'unique': i.isUnique(), 'backend': i.isMixedIndex() ? i.getBackingIndex() : '', " +
+		"'keys': i.getFieldKeys().collect{ k -> ['name': k.name(), 'status': 'SchemaStatus.' + i.getIndexStatus(k).name()] }] };" +
+		"edgeIndex = { i -> ['name': i.name(), 'type': 'vertex-centric', 'unique': false, 'label': i.getType().name(), " +
+		"'direction': i.getDirection().name(), 'order': 'Order.' + i.getSortOrder().name(), " +
+		"'keys': i.getSortKey().collect{ k -> ['name': k.name(), 'status': 'SchemaStatus.' + i.getIndexStatus().name()] }] };" +
+		"r = mgmt.getGraphIndexes(Vertex.class).collect{ graphIndex(it, 'Vertex.class') } + " +
+		"mgmt.getGraphIndexes(Edge.class).collect{ graphIndex(it, 'Edge.class') } + " +
+		"mgmt.getGraphIndexes(VertexProperty.class).collect{ graphIndex(it, 'VertexProperty.class') } + " +
+		"mgmt.getRelationTypes(EdgeLabel.class).collectMany{ l -> mgmt.getRelationIndexes(l).collect{ edgeIndex(it) } };" +
+		"mgmt.rollback();r"
+
+	connectionsScript = "mgmt = graph.openManagement();" +
+		"r = mgmt.getRelationTypes(EdgeLabel.class).collectMany{ l -> l.mappedConnections().collect{ c -> " +
+		"['edgeLabel': l.name(), 'outVertexLabel': c.getOutgoingVertexLabel().name(), " +
+		"'inVertexLabel': c.getIncomingVertexLabel().name()] } };" +
+		"mgmt.rollback();r"
+)
+
+// PropertyKeys returns every property key
+// in the schema with its data type and cardinality.
+func (s *schemaManager) PropertyKeys() ([]model.PropertyKey, error) {
+	var keys []model.PropertyKey
+	err := s.readSchema("PropertyKeys", propertyKeysScript, &keys)
+	return keys, err
+}
+
+// EdgeLabels returns every edge label
+// in the schema with its multiplicity.
+func (s *schemaManager) EdgeLabels() ([]model.EdgeLabel, error) {
+	var labels []model.EdgeLabel
+	err := s.readSchema("EdgeLabels", edgeLabelsScript, &labels)
+	return labels, err
+}
+
+// VertexLabels returns every vertex label in the
+// schema and whether it is partitioned or static.
+func (s *schemaManager) VertexLabels() ([]model.VertexLabel, error) {
+	var labels []model.VertexLabel
+	err := s.readSchema("VertexLabels", vertexLabelsScript, &labels)
+	return labels, err
+}
+
+// Indexes returns every graph and vertex-centric index
+// in the schema with its keys and their status.
+func (s *schemaManager) Indexes() ([]model.Index, error) {
+	var indexes []model.Index
+	err := s.readSchema("Indexes", indexesScript, &indexes)
+	return indexes, err
+}
+
+// Connections returns every edge label that is
+// allowed between two vertex labels in the schema.
+func (s *schemaManager) Connections() ([]model.Connection, error) {
+	var connections []model.Connection
+	err := s.readSchema("Connections", connectionsScript, &connections)
+	return connections, err
+}
+
+func (s *schemaManager) readSchema(function, script string, v interface{}) error {
+	data, err := s.executeStringQuery(script)
+	if err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError(function, script, err),
+		)
+		return err
+	}
+
+	if err = model.UnmarshalValues(data, v); err != nil {
+		s.logger.Error("schema unmarshal",
+			gremerror.NewGrammesError(function, err),
+		)
+		return err
+	}
+
+	return nil
+}
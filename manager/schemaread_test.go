@@ -0,0 +1,127 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+var schemaResponses = map[string]string{
+	propertyKeysScript: `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","age","dataType","Integer.class","cardinality","SINGLE"]}]}`,
+	edgeLabelsScript:   `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","knows","multiplicity","MULTI","directed",true,"unidirected",false]}]}`,
+	vertexLabelsScript: `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","person","partitioned",false,"static",true]}]}`,
+	indexesScript: `{"@type":"g:List","@value":[` +
+		`{"@type":"g:Map","@value":["name","byName","type","composite","element","Vertex.class","unique",true,"backend","","keys",{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","name","status","SchemaStatus.ENABLED"]}]}]},` +
+		`{"@type":"g:Map","@value":["name","byTime","type","vertex-centric","unique",false,"label","battled","direction","BOTH","order","Order.desc","keys",{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","time","status","SchemaStatus.REGISTERED"]}]}]}]}`,
+	connectionsScript: `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["edgeLabel","knows","outVertexLabel","person","inVertexLabel","person"]}]}`,
+}
+
+func TestSchemaIntrospection(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(q string) ([][]byte, error) {
+			return [][]byte{[]byte(schemaResponses[q])}, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When PropertyKeys is called", func() {
+			keys, err := sm.PropertyKeys()
+			Convey("Then the keys should be unmarshalled", func() {
+				So(err, ShouldBeNil)
+				So(keys, ShouldResemble, []model.PropertyKey{{Name: "age", DataType: datatype.Integer, Cardinality: cardinality.Single}})
+			})
+		})
+
+		Convey("When EdgeLabels is called", func() {
+			labels, err := sm.EdgeLabels()
+			Convey("Then the labels should be unmarshalled", func() {
+				So(err, ShouldBeNil)
+				So(labels, ShouldResemble, []model.EdgeLabel{{Name: "knows", Multiplicity: multiplicity.Multi, Directed: true}})
+			})
+		})
+
+		Convey("When VertexLabels is called", func() {
+			labels, err := sm.VertexLabels()
+			Convey("Then the labels should be unmarshalled", func() {
+				So(err, ShouldBeNil)
+				So(labels, ShouldResemble, []model.VertexLabel{{Name: "person", Static: true}})
+			})
+		})
+
+		Convey("When Indexes is called", func() {
+			indexes, err := sm.Indexes()
+			Convey("Then the graph and vertex-centric indexes should be unmarshalled", func() {
+				So(err, ShouldBeNil)
+				So(indexes, ShouldHaveLength, 2)
+				So(indexes[0], ShouldResemble, model.Index{
+					Name: "byName", Type: model.CompositeIndex, Element: index.Vertex, Unique: true,
+					Keys: []model.IndexKey{{Name: "name", Status: index.Enabled}},
+				})
+				So(indexes[1], ShouldResemble, model.Index{
+					Name: "byTime", Type: model.EdgeIndex, Label: "battled", Direction: direction.Both, Order: order.Desc,
+					Keys: []model.IndexKey{{Name: "time", Status: index.Registered}},
+				})
+			})
+		})
+
+		Convey("When Connections is called", func() {
+			connections, err := sm.Connections()
+			Convey("Then the connections should be unmarshalled", func() {
+				So(err, ShouldBeNil)
+				So(connections, ShouldResemble, []model.Connection{{EdgeLabel: "knows", OutVertexLabel: "person", InVertexLabel: "person"}})
+			})
+		})
+	})
+}
+
+func TestSchemaIntrospectionErrors(t *testing.T) {
+	Convey("Given a string executor that fails and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When PropertyKeys is called", func() {
+			_, err := sm.PropertyKeys()
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a string executor with an invalid response and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(`{"name":`)}, nil }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When Indexes is called", func() {
+			_, err := sm.Indexes()
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,383 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"encoding/json"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/merge"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// Upserts look up the element first and fold what they find, then
+// servers running TinkerPop 3.6 or newer are sent a mergeV() or
+// mergeE() step while older servers are sent a coalesce() of the
+// found element and the created one. Each upsert projects the element
+// and whether it was created, which is told from what was found, and
+// a batch of upserts is a union() of them so it's a single traversal.
+
+const (
+	upsertElement  = "element"
+	upsertCreated  = "created"
+	upsertExisting = "existing"
+)
+
+// UpsertVertex will find the vertex with the given label and
+// matching properties or create it when there isn't one, then set
+// the set properties on it. It returns the vertex and whether it was
+// created. When more than one vertex matches only the first is used.
+func (v *addVertexQueryManager) UpsertVertex(label string, match, set map[string]interface{}) (model.Vertex, bool, error) {
+	upserted, err := v.UpsertVertices(model.VertexUpsert{Label: label, Match: match, Set: set})
+	if err != nil {
+		return nilVertex, false, err
+	}
+
+	return upserted[0].Vertex, upserted[0].Created, nil
+}
+
+// UpsertVertices upserts every vertex in a single query
+// and returns the results in the same order.
+func (v *addVertexQueryManager) UpsertVertices(upserts ...model.VertexUpsert) ([]model.UpsertedVertex, error) {
+	if len(upserts) == 0 {
+		return nil, nil
+	}
+
+	var (
+		b          = newBindings()
+		traversals = make([]traversal.String, 0, len(upserts))
+		useMerge   = v.version.atLeast(3, 6)
+	)
+
+	for _, u := range upserts {
+		for _, props := range []map[string]interface{}{u.Match, u.Set} {
+			if err := v.schema.validate(propertyList(props)...); err != nil {
+				v.logger.Error("UpsertVertices: invalid property", err)
+				return nil, err
+			}
+		}
+
+		traversals = append(traversals, upsertVertexQuery(b, u, useMerge))
+	}
+
+	results, err := executeUpserts(v.executeBoundStringQuery, "UpsertVertices", batchQuery(traversals), b, len(upserts))
+	if err != nil {
+		v.logger.Error("UpsertVertices: invalid query upserting vertices", err)
+		return nil, err
+	}
+
+	upserted := make([]model.UpsertedVertex, len(results))
+	for i, r := range results {
+		if err = jsonUnmarshal(r.element, &upserted[i].Vertex); err != nil {
+			v.logger.Error("vertex unmarshal",
+				gremerror.NewUnmarshalError("UpsertVertices", r.element, err),
+			)
+			return nil, err
+		}
+		upserted[i].Created = r.created
+	}
+
+	return upserted, nil
+}
+
+// UpsertEdge will find the edge with the label going out of the
+// vertex with the outID and into the vertex with the inID or create
+// it when there isn't one, then set the properties on it. It returns
+// the edge and whether it was created. When more than one edge
+// matches only the first is used.
+func (e *edgeQueryManager) UpsertEdge(outID, inID int64, label string, properties map[string]interface{}) (model.Edge, bool, error) {
+	upserted, err := e.UpsertEdges(model.EdgeUpsert{OutID: outID, InID: inID, Label: label, Properties: properties})
+	if err != nil {
+		return nilEdge, false, err
+	}
+
+	return upserted[0].Edge, upserted[0].Created, nil
+}
+
+// UpsertEdges upserts every edge in a single query
+// and returns the results in the same order.
+func (e *edgeQueryManager) UpsertEdges(upserts ...model.EdgeUpsert) ([]model.UpsertedEdge, error) {
+	if len(upserts) == 0 {
+		return nil, nil
+	}
+
+	var (
+		b          = newBindings()
+		traversals = make([]traversal.String, 0, len(upserts))
+		useMerge   = e.version.atLeast(3, 6)
+	)
+
+	for _, u := range upserts {
+		if err := e.schema.validate(propertyList(u.Properties)...); err != nil {
+			e.logger.Error("UpsertEdges: invalid property", err)
+			return nil, err
+		}
+
+		traversals = append(traversals, upsertEdgeQuery(b, u, useMerge))
+	}
+
+	results, err := executeUpserts(e.executeBoundStringQuery, "UpsertEdges", batchQuery(traversals), b, len(upserts))
+	if err != nil {
+		e.logger.Error("UpsertEdges: invalid query upserting edges", err)
+		return nil, err
+	}
+
+	upserted := make([]model.UpsertedEdge, len(results))
+	for i, r := range results {
+		if err = jsonUnmarshal(r.element, &upserted[i].Edge); err != nil {
+			e.logger.Error("edge unmarshal",
+				gremerror.NewUnmarshalError("UpsertEdges", r.element, err),
+			)
+			return nil, err
+		}
+		upserted[i].Created = r.created
+	}
+
+	return upserted, nil
+}
+
+// upsertVertexQuery renders a vertex upsert with its strings bound.
+func upsertVertexQuery(b *bindings, u model.VertexUpsert, useMerge bool) traversal.String {
+	label := b.bind(u.Label)
+
+	query := traversal.NewTraversal().V()
+	query.AddStep("hasLabel", label)
+	for _, k := range sortedKeys(u.Match) {
+		query.AddStep("has", b.bind(k), b.bind(u.Match[k]))
+	}
+	query = query.Limit(1).Fold()
+
+	if useMerge {
+		search := bindMap(b, token.Label, label, u.Match)
+		return mergeUpsert(query, "mergeV", search, bindMap(b, nil, nil, u.Set))
+	}
+
+	found := traversal.NewTraversal().Unfold()
+	setProperties(b, &found, u.Set)
+
+	created := traversal.NewTraversal()
+	created.AddStep("addV", label)
+	setProperties(b, &created, u.Match)
+	setProperties(b, &created, u.Set)
+
+	return query.Coalesce(projectUpsert(found, false), projectUpsert(created, true))
+}
+
+// upsertEdgeQuery renders an edge upsert with its strings bound.
+func upsertEdgeQuery(b *bindings, u model.EdgeUpsert, useMerge bool) traversal.String {
+	label := b.bind(u.Label)
+
+	query := traversal.NewTraversal().V().HasID(u.OutID)
+	query.AddStep("outE", label)
+	query = query.Where(traversal.NewTraversal().InV().HasID(u.InID).Raw()).Limit(1).Fold()
+
+	if useMerge {
+		search := traversal.Map{
+			{Key: token.Label, Value: label},
+			{Key: direction.Out, Value: u.OutID},
+			{Key: direction.In, Value: u.InID},
+		}
+		return mergeUpsert(query, "mergeE", search, bindMap(b, nil, nil, u.Properties))
+	}
+
+	found := traversal.NewTraversal().Unfold()
+	setProperties(b, &found, u.Properties)
+
+	created := traversal.NewTraversal()
+	created.AddStep("addE", label)
+	created = created.From(traversal.NewTraversal().V().HasID(u.OutID).Raw()).To(traversal.NewTraversal().V().HasID(u.InID).Raw())
+	setProperties(b, &created, u.Properties)
+
+	return query.Coalesce(projectUpsert(found, false), projectUpsert(created, true))
+}
+
+// mergeUpsert merges the element with the mergeV() or mergeE() step,
+// setting the properties whether it's created or matched, and projects
+// it with whether it was created, which is when nothing was found.
+func mergeUpsert(found traversal.String, step string, search, set traversal.Map) traversal.String {
+	query := found.As(upsertExisting)
+	query.AddStep(step, search)
+	if len(set) > 0 {
+		query = query.MergeOption(merge.OnCreate, append(append(traversal.Map{}, search...), set...)).
+			MergeOption(merge.OnMatch, set)
+	}
+
+	created := traversal.NewTraversal().Choose(traversal.NewTraversal().Select(upsertExisting).Unfold().Raw(),
+		traversal.NewTraversal().Constant("false").Raw(),
+		traversal.NewTraversal().Constant("true").Raw()).Raw()
+
+	return query.Project(upsertElement, upsertCreated).By().By(created)
+}
+
+// setProperties adds a property step for each of the properties.
+func setProperties(b *bindings, query *traversal.String, props map[string]interface{}) {
+	for _, k := range sortedKeys(props) {
+		query.AddStep("property", b.bind(k), b.bind(props[k]))
+	}
+}
+
+// bindMap renders the properties as a map with their strings
+// bound, after the key and value when the key isn't nil.
+func bindMap(b *bindings, key, value interface{}, props map[string]interface{}) traversal.Map {
+	var m traversal.Map
+	if key != nil {
+		m = append(m, traversal.MapEntry{Key: key, Value: value})
+	}
+	for _, k := range sortedKeys(props) {
+		m = append(m, traversal.MapEntry{Key: b.bind(k), Value: b.bind(props[k])})
+	}
+
+	return m
+}
+
+// projectUpsert projects the element of the coalesce()
+// branch with whether the branch creates it.
+func projectUpsert(branch traversal.String, created bool) traversal.String {
+	flag := "false"
+	if created {
+		flag = "true"
+	}
+
+	return branch.Project(upsertElement, upsertCreated).By().By(traversal.NewTraversal().Constant(flag).Raw()).Raw()
+}
+
+// batchQuery renders a single traversal as it is
+// and several traversals as branches of a union().
+func batchQuery(traversals []traversal.String) traversal.String {
+	if len(traversals) == 1 {
+		return traversals[0]
+	}
+
+	branches := make([]traversal.String, len(traversals))
+	for i, t := range traversals {
+		branches[i] = t.Raw()
+	}
+
+	query := traversal.NewTraversal()
+	query.AddStep("inject", 0)
+
+	return query.Union(branches...)
+}
+
+// propertyList flattens the properties into keys and values.
+func propertyList(props map[string]interface{}) []interface{} {
+	list := make([]interface{}, 0, len(props)*2)
+	for _, k := range sortedKeys(props) {
+		list = append(list, k, props[k])
+	}
+
+	return list
+}
+
+// upsertResult is the element projected by an
+// upsert with whether the upsert created it.
+type upsertResult struct {
+	element json.RawMessage
+	created bool
+}
+
+// executeUpserts executes the upserts and decodes their
+// results, which must be one for each of the upserts.
+func executeUpserts(execute executor, function string, query traversal.String, b *bindings, count int) ([]upsertResult, error) {
+	responses, err := execute(query.String(), b.values, map[string]string{})
+	if err != nil {
+		return nil, gremerror.NewQueryError(function, query.String(), err)
+	}
+
+	var results []upsertResult
+
+	for _, res := range responses {
+		var items []json.RawMessage
+		if items, err = unmarshalRawList(res); err != nil {
+			return nil, gremerror.NewUnmarshalError(function, res, err)
+		}
+
+		for _, item := range items {
+			var r upsertResult
+			if r, err = unmarshalUpsertResult(item); err != nil {
+				return nil, gremerror.NewUnmarshalError(function, item, err)
+			}
+			results = append(results, r)
+		}
+	}
+
+	if len(results) != count {
+		return nil, gremerror.NewGrammesError(function, gremerror.ErrEmptyResponse)
+	}
+
+	return results, nil
+}
+
+// unmarshalRawList decodes a typed or plain list
+// without decoding the items in it.
+func unmarshalRawList(data []byte) ([]json.RawMessage, error) {
+	var typed struct {
+		Value []json.RawMessage `json:"@value"`
+	}
+
+	if err := jsonUnmarshal(data, &typed); err == nil {
+		return typed.Value, nil
+	}
+
+	var plain []json.RawMessage
+	err := jsonUnmarshal(data, &plain)
+
+	return plain, err
+}
+
+// unmarshalUpsertResult decodes the map projected by an upsert.
+func unmarshalUpsertResult(data []byte) (upsertResult, error) {
+	var (
+		r     upsertResult
+		entry []json.RawMessage
+		typed struct {
+			Value []json.RawMessage `json:"@value"`
+		}
+	)
+
+	if err := jsonUnmarshal(data, &typed); err == nil {
+		entry = typed.Value
+	}
+
+	for i := 0; i+1 < len(entry); i += 2 {
+		var key string
+		if err := jsonUnmarshal(entry[i], &key); err != nil {
+			return r, err
+		}
+
+		switch key {
+		case upsertElement:
+			r.element = entry[i+1]
+		case upsertCreated:
+			if err := jsonUnmarshal(entry[i+1], &r.created); err != nil {
+				return r, err
+			}
+		}
+	}
+
+	if r.element == nil {
+		return r, gremerror.ErrEmptyResponse
+	}
+
+	return r, nil
+}
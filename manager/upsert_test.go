@@ -0,0 +1,238 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+var (
+	upsertedVertexResponse = `{"@type":"g:List","@value":[` +
+		`{"@type":"g:Map","@value":["element",{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":1},"label":"person"}},"created",true]}]}`
+	upsertedVerticesResponse = `{"@type":"g:List","@value":[` +
+		`{"@type":"g:Map","@value":["element",{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":1},"label":"person"}},"created",true]},` +
+		`{"@type":"g:Map","@value":["element",{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":2},"label":"person"}},"created",false]}]}`
+	upsertedEdgeResponse = `{"@type":"g:List","@value":[` +
+		`{"@type":"g:Map","@value":["element",{"@type":"g:Edge","@value":{"id":{"@type":"janusgraph:RelationIdentifier","@value":{"relationId":"a"}},` +
+		`"label":"knows","inV":{"@type":"g:Int64","@value":2},"outV":{"@type":"g:Int64","@value":1}}},"created",false]}]}`
+)
+
+type boundQuery struct {
+	query    string
+	bindings map[string]string
+}
+
+func mockBoundExecutor(queries *[]boundQuery, response string, err error) executor {
+	return func(q string, bindings, _ map[string]string) ([][]byte, error) {
+		*queries = append(*queries, boundQuery{q, bindings})
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{[]byte(response)}, nil
+	}
+}
+
+// mockVersionExecutor answers the version lookup with the version.
+func mockVersionExecutor(version string) stringExecutor {
+	return func(string) ([][]byte, error) {
+		return [][]byte{[]byte(`["` + version + `"]`)}, nil
+	}
+}
+
+func TestUpsertVertex(t *testing.T) {
+	Convey("Given an add vertex query manager", t, func() {
+		var queries []boundQuery
+		vm := newAddVertexQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.5.4"))
+		vm.executeBoundStringQuery = mockBoundExecutor(&queries, upsertedVertexResponse, nil)
+		Convey("When UpsertVertex is called", func() {
+			vertex, created, err := vm.UpsertVertex("person",
+				map[string]interface{}{"name": `D"Arcy`},
+				map[string]interface{}{"age": 30},
+			)
+			Convey("Then the upsert should be sent with its strings bound", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldHaveLength, 1)
+				So(queries[0].query, ShouldEqual, `g.V().hasLabel(b0).has(b1,b2).limit(1).fold().coalesce(`+
+					`unfold().property(b3,30).project("element","created").by().by(constant(false)),`+
+					`addV(b0).property(b1,b2).property(b3,30).project("element","created").by().by(constant(true)))`)
+				So(queries[0].bindings, ShouldResemble, map[string]string{"b0": "person", "b1": "name", "b2": `D"Arcy`, "b3": "age"})
+			})
+			Convey("Then the vertex and whether it was created should be returned", func() {
+				So(vertex.ID(), ShouldEqual, 1)
+				So(created, ShouldBeTrue)
+			})
+		})
+		Convey("When UpsertVertices is called", func() {
+			vm.executeBoundStringQuery = mockBoundExecutor(&queries, upsertedVerticesResponse, nil)
+			upserted, err := vm.UpsertVertices(
+				model.VertexUpsert{Label: "person", Match: map[string]interface{}{"name": "a"}},
+				model.VertexUpsert{Label: "person", Match: map[string]interface{}{"name": "b"}},
+			)
+			Convey("Then the upserts should be sent in one union", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldHaveLength, 1)
+				So(queries[0].query, ShouldStartWith, "g.inject(0).union(V().hasLabel(b0).has(b1,b2)")
+				So(queries[0].query, ShouldContainSubstring, ",V().hasLabel(b0).has(b1,b3)")
+			})
+			Convey("Then the results should be returned in order", func() {
+				So(upserted, ShouldHaveLength, 2)
+				So(upserted[0].Vertex.ID(), ShouldEqual, 1)
+				So(upserted[0].Created, ShouldBeTrue)
+				So(upserted[1].Vertex.ID(), ShouldEqual, 2)
+				So(upserted[1].Created, ShouldBeFalse)
+			})
+		})
+		Convey("When UpsertVertices is called without upserts", func() {
+			upserted, err := vm.UpsertVertices()
+			Convey("Then nothing should be sent", func() {
+				So(err, ShouldBeNil)
+				So(upserted, ShouldBeEmpty)
+				So(queries, ShouldBeEmpty)
+			})
+		})
+		Convey("When the number of results doesn't match the upserts", func() {
+			vm.executeBoundStringQuery = mockBoundExecutor(&queries, upsertedVerticesResponse, nil)
+			_, err := vm.UpsertVertices(model.VertexUpsert{Label: "person"})
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given an add vertex query manager for a TinkerPop 3.6 server", t, func() {
+		var queries []boundQuery
+		vm := newAddVertexQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.6.2"))
+		vm.executeBoundStringQuery = mockBoundExecutor(&queries, upsertedVertexResponse, nil)
+		Convey("When UpsertVertex is called", func() {
+			_, created, err := vm.UpsertVertex("person",
+				map[string]interface{}{"name": `D"Arcy`},
+				map[string]interface{}{"age": 30},
+			)
+			Convey("Then the upsert should be sent with the mergeV step", func() {
+				So(err, ShouldBeNil)
+				So(created, ShouldBeTrue)
+				So(queries[0].query, ShouldEqual, `g.V().hasLabel(b0).has(b1,b2).limit(1).fold().as("existing")`+
+					`.mergeV([(T.label):b0,(b1):b2])`+
+					`.option(Merge.onCreate,[(T.label):b0,(b1):b2,(b3):30])`+
+					`.option(Merge.onMatch,[(b3):30])`+
+					`.project("element","created").by()`+
+					`.by(choose(select("existing").unfold(),constant(false),constant(true)))`)
+				So(queries[0].bindings, ShouldResemble, map[string]string{"b0": "person", "b1": "name", "b2": `D"Arcy`, "b3": "age"})
+			})
+		})
+		Convey("When UpsertVertex is called without properties to set", func() {
+			vm.UpsertVertex("person", map[string]interface{}{"name": "a"}, nil)
+			Convey("Then no options should be sent", func() {
+				So(queries[0].query, ShouldContainSubstring, `.mergeV([(T.label):b0,(b1):b2]).project(`)
+			})
+		})
+	})
+
+	Convey("Given an add vertex query manager with schema validation", t, func() {
+		var queries []boundQuery
+		vm := newAddVertexQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.5.4"))
+		vm.executeBoundStringQuery = mockBoundExecutor(&queries, upsertedVertexResponse, nil)
+		vm.schema = newPropertySchema(func() ([]model.PropertyKey, error) {
+			return []model.PropertyKey{{Name: "age", DataType: datatype.Integer, Cardinality: cardinality.Single}}, nil
+		})
+		vm.schema.setEnabled(true)
+		Convey("When UpsertVertex is called with an invalid property", func() {
+			_, _, err := vm.UpsertVertex("person", nil, map[string]interface{}{"age": "thirty"})
+			Convey("Then a property error should be returned without a query", func() {
+				So(err, ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+				So(queries, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given an add vertex query manager that encounters an error", t, func() {
+		var queries []boundQuery
+		vm := newAddVertexQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.5.4"))
+		vm.executeBoundStringQuery = mockBoundExecutor(&queries, "", errors.New("ERROR"))
+		Convey("When UpsertVertex is called", func() {
+			_, _, err := vm.UpsertVertex("person", nil, nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertEdge(t *testing.T) {
+	Convey("Given an edge query manager", t, func() {
+		var queries []boundQuery
+		em := newEdgeQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.5.4"))
+		em.executeBoundStringQuery = mockBoundExecutor(&queries, upsertedEdgeResponse, nil)
+		Convey("When UpsertEdge is called", func() {
+			edge, created, err := em.UpsertEdge(1, 2, "knows", map[string]interface{}{"since": 2010})
+			Convey("Then the upsert should be sent with its strings bound", func() {
+				So(err, ShouldBeNil)
+				So(queries[0].query, ShouldEqual, `g.V().hasId(1).outE(b0).where(inV().hasId(2)).limit(1).fold().coalesce(`+
+					`unfold().property(b1,2010).project("element","created").by().by(constant(false)),`+
+					`addE(b0).from(V().hasId(1)).to(V().hasId(2)).property(b1,2010).project("element","created").by().by(constant(true)))`)
+				So(queries[0].bindings, ShouldResemble, map[string]string{"b0": "knows", "b1": "since"})
+			})
+			Convey("Then the edge and whether it was created should be returned", func() {
+				So(edge.ID(), ShouldEqual, "a")
+				So(created, ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given an edge query manager for a TinkerPop 3.6 server", t, func() {
+		var queries []boundQuery
+		em := newEdgeQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.6.2"))
+		em.executeBoundStringQuery = mockBoundExecutor(&queries, upsertedEdgeResponse, nil)
+		Convey("When UpsertEdge is called", func() {
+			_, _, err := em.UpsertEdge(1, 2, "knows", map[string]interface{}{"since": 2010})
+			Convey("Then the upsert should be sent with the mergeE step", func() {
+				So(err, ShouldBeNil)
+				So(queries[0].query, ShouldEqual, `g.V().hasId(1).outE(b0).where(inV().hasId(2)).limit(1).fold().as("existing")`+
+					`.mergeE([(T.label):b0,(OUT):1,(IN):2])`+
+					`.option(Merge.onCreate,[(T.label):b0,(OUT):1,(IN):2,(b1):2010])`+
+					`.option(Merge.onMatch,[(b1):2010])`+
+					`.project("element","created").by()`+
+					`.by(choose(select("existing").unfold(),constant(false),constant(true)))`)
+				So(queries[0].bindings, ShouldResemble, map[string]string{"b0": "knows", "b1": "since"})
+			})
+		})
+	})
+
+	Convey("Given an edge query manager that receives an invalid response", t, func() {
+		var queries []boundQuery
+		em := newEdgeQueryManager(logging.NewNilLogger(), mockVersionExecutor("3.5.4"))
+		em.executeBoundStringQuery = mockBoundExecutor(&queries, `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["created",true]}]}`, nil)
+		Convey("When UpsertEdge is called", func() {
+			_, _, err := em.UpsertEdge(1, 2, "knows", nil)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
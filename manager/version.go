@@ -0,0 +1,133 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// serverVersion looks up the TinkerPop version of the server the
+// first time it's needed and remembers it. A lookup that fails to
+// reach the server isn't remembered so the next call tries again.
+type serverVersion struct {
+	mu                 sync.Mutex
+	known              bool
+	version            []int
+	executeStringQuery stringExecutor
+}
+
+func newServerVersion(executeString stringExecutor) *serverVersion {
+	return &serverVersion{
+		executeStringQuery: executeString,
+	}
+}
+
+// atLeast reports whether the server runs the given TinkerPop
+// version or newer. Servers that won't report their version,
+// such as those that don't evaluate Groovy, are treated as older.
+func (s *serverVersion) atLeast(major, minor int) bool {
+	s.mu.Lock()
+	version, known := s.version, s.known
+	s.mu.Unlock()
+
+	// The lookup is made without holding the lock so callers
+	// aren't queued behind it. Concurrent first callers may
+	// each look it up, and they all find the same version.
+	if !known {
+		if version, known = s.lookup(); known {
+			s.mu.Lock()
+			s.version, s.known = version, true
+			s.mu.Unlock()
+		}
+	}
+
+	if len(version) < 2 {
+		return false
+	}
+
+	if version[0] != major {
+		return version[0] > major
+	}
+	return version[1] >= minor
+}
+
+// lookup returns the version of the server and whether the
+// server answered. A server that answers with an error or
+// without a version it understands is answered with no version.
+func (s *serverVersion) lookup() ([]int, bool) {
+	responses, err := s.executeStringQuery("Gremlin.version()")
+	if err != nil {
+		return nil, answered(err)
+	}
+
+	if len(responses) == 0 {
+		return nil, true
+	}
+
+	var (
+		list     model.List
+		versions []string
+		version  []int
+	)
+
+	// The version may come back as a typed GraphSON list or a plain one.
+	if err = jsonUnmarshal(responses[0], &list); err == nil && len(list.Value) > 0 {
+		if v, ok := list.Value[0].(string); ok {
+			versions = append(versions, v)
+		}
+	} else if err = jsonUnmarshal(responses[0], &versions); err != nil {
+		return nil, true
+	}
+
+	if len(versions) == 0 {
+		return nil, true
+	}
+
+	for _, part := range strings.Split(versions[0], ".") {
+		n, err := strconv.Atoi(strings.SplitN(part, "-", 2)[0])
+		if err != nil {
+			break
+		}
+		version = append(version, n)
+	}
+
+	return version, true
+}
+
+// answered returns whether the error is the server's answer to
+// the query, rather than a failure that may not happen again
+// such as a timeout or a lost connection.
+func answered(err error) bool {
+	if t, ok := err.(*gremerror.NetworkError); ok {
+		switch t.StatusCode() {
+		case 500, 503, 598:
+			return false
+		}
+		return true
+	}
+
+	return false
+}
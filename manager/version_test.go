@@ -0,0 +1,105 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+func TestServerVersion(t *testing.T) {
+	Convey("Given a server that fails to answer the version lookup once", t, func() {
+		var lookups int
+		execute := func(string) ([][]byte, error) {
+			lookups++
+			if lookups == 1 {
+				return nil, errors.New("ERROR")
+			}
+			return [][]byte{[]byte(`["3.6.2"]`)}, nil
+		}
+		version := newServerVersion(execute)
+		Convey("When atLeast is called after the failure", func() {
+			first := version.atLeast(3, 6)
+			second := version.atLeast(3, 6)
+			third := version.atLeast(3, 6)
+			Convey("Then the version should be looked up again and then remembered", func() {
+				So(first, ShouldBeFalse)
+				So(second, ShouldBeTrue)
+				So(third, ShouldBeTrue)
+				So(lookups, ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given a server that answers without a version", t, func() {
+		var lookups int
+		execute := func(string) ([][]byte, error) {
+			lookups++
+			return [][]byte{[]byte(`"unknown"`)}, nil
+		}
+		version := newServerVersion(execute)
+		Convey("When atLeast is called twice", func() {
+			version.atLeast(3, 6)
+			older := version.atLeast(3, 6)
+			Convey("Then the server should be treated as older and only asked once", func() {
+				So(older, ShouldBeFalse)
+				So(lookups, ShouldEqual, 1)
+			})
+		})
+	})
+
+	Convey("Given a server that won't evaluate the version lookup", t, func() {
+		var lookups int
+		execute := func(string) ([][]byte, error) {
+			lookups++
+			return nil, gremerror.NewNetworkError(597, "SCRIPT EVALUATION ERROR")
+		}
+		version := newServerVersion(execute)
+		Convey("When atLeast is called twice", func() {
+			version.atLeast(3, 6)
+			older := version.atLeast(3, 6)
+			Convey("Then the server should be treated as older and only asked once", func() {
+				So(older, ShouldBeFalse)
+				So(lookups, ShouldEqual, 1)
+			})
+		})
+	})
+
+	Convey("Given a server that times out answering the version lookup", t, func() {
+		var lookups int
+		execute := func(string) ([][]byte, error) {
+			lookups++
+			return nil, gremerror.NewNetworkError(598, "SERVER TIMEOUT")
+		}
+		version := newServerVersion(execute)
+		Convey("When atLeast is called twice", func() {
+			version.atLeast(3, 6)
+			version.atLeast(3, 6)
+			Convey("Then the version should be looked up again", func() {
+				So(lookups, ShouldEqual, 2)
+			})
+		})
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package model
+
+// BulkVertex is a vertex to add with a bulk load. The Key is
+// an external key, such as a key from the source of the data,
+// that edges of the same load use to refer to the vertex.
+type BulkVertex struct {
+	Key        string
+	Label      string
+	Properties map[string]interface{}
+}
+
+// BulkEdge is an edge to add with a bulk load. Each end is the
+// vertex of the load with the external key or, when the key is
+// empty, the vertex already in the graph with the ID.
+type BulkEdge struct {
+	OutKey     string
+	InKey      string
+	OutID      int64
+	InID       int64
+	Label      string
+	Properties map[string]interface{}
+}
+
+// BulkFailure is an element that a bulk
+// operation couldn't add and the reason.
+type BulkFailure struct {
+	// Index is the index of the element in
+	// the elements given to the operation.
+	Index int
+	Err   error
+}
+
+// BulkResult is the outcome of a bulk load.
+type BulkResult struct {
+	// IDs are the IDs of the added vertices by their external keys.
+	IDs            map[string]int64
+	VertexFailures []BulkFailure
+	EdgeFailures   []BulkFailure
+}
+
+// BulkProgress is reported after each batch of a bulk operation.
+type BulkProgress struct {
+	Batches int
+	Done    int64
+	Failed  int64
+	// Total is the number of elements in the
+	// operation, or zero when it isn't known.
+	Total int64
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+// Migration is a versioned change to the schema. The schema
+// types it defines are created if they are missing and then
+// the statements, which start from graph.NewManagement(),
+// are run in the same management transaction.
+type Migration struct {
+	Version     int64          `json:"version" yaml:"version"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Schema      Schema         `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Statements  []graph.String `json:"statements,omitempty" yaml:"statements,omitempty"`
+}
+
+// Checksum identifies what the migration changes so a
+// migration can't be edited once it has been applied.
+// The description isn't part of the checksum.
+func (m Migration) Checksum() string {
+	data, _ := json.Marshal(struct {
+		Schema     Schema         `json:"schema"`
+		Statements []graph.String `json:"statements"`
+	}{m.Schema, m.Statements})
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// AppliedMigration is the record of
+// a migration that has been applied.
+type AppliedMigration struct {
+	Version     int64  `json:"version"`
+	Description string `json:"description"`
+	Checksum    string `json:"checksum"`
+	// AppliedAt is when the migration was
+	// applied in milliseconds since the epoch.
+	AppliedAt int64 `json:"appliedAt"`
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+func TestMigrationChecksum(t *testing.T) {
+	Convey("Given a migration", t, func() {
+		m := Migration{
+			Version:     1,
+			Description: "names",
+			Schema:      Schema{PropertyKeys: []PropertyKey{{Name: "name", DataType: datatype.String}}},
+			Statements:  []graph.String{graph.NewManagement().MakeVertexLabel("person").Make()},
+		}
+		Convey("When the checksum is taken twice", func() {
+			Convey("Then it should be the same", func() {
+				So(m.Checksum(), ShouldEqual, m.Checksum())
+				So(m.Checksum(), ShouldHaveLength, 64)
+			})
+		})
+
+		Convey("When only the description changes", func() {
+			changed := m
+			changed.Description = "person names"
+			Convey("Then the checksum should be the same", func() {
+				So(changed.Checksum(), ShouldEqual, m.Checksum())
+			})
+		})
+
+		Convey("When the statements change", func() {
+			changed := m
+			changed.Statements = []graph.String{graph.NewManagement().MakeVertexLabel("people").Make()}
+			Convey("Then the checksum should change", func() {
+				So(changed.Checksum(), ShouldNotEqual, m.Checksum())
+			})
+		})
+
+		Convey("When the schema changes", func() {
+			changed := m
+			changed.Schema = Schema{PropertyKeys: []PropertyKey{{Name: "name", DataType: datatype.Integer}}}
+			Convey("Then the checksum should change", func() {
+				So(changed.Checksum(), ShouldNotEqual, m.Checksum())
+			})
+		})
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/geoshape"
+)
+
+// Validate checks that the values can be stored as the property
+// key, so mistakes are returned before a query is sent to the
+// server. Only strings, booleans, numbers and Geoshapes are checked
+// since other values, such as traversals, are evaluated by the server.
+// Values of data types without a Go equivalent aren't checked.
+func (k PropertyKey) Validate(values ...interface{}) error {
+	if k.Cardinality == cardinality.Single && len(values) > 1 {
+		return gremerror.NewPropertyError(k.Name, values,
+			"key has SINGLE cardinality but was given "+strconv.Itoa(len(values))+" values")
+	}
+
+	for _, v := range values {
+		if !fitsDataType(k.DataType, v) {
+			return gremerror.NewPropertyError(k.Name, v,
+				"value of type "+typeName(v)+" is not a "+k.DataType.String())
+		}
+	}
+
+	return nil
+}
+
+// fitsDataType reports whether the value can be stored as the data type.
+func fitsDataType(dt datatype.DataType, value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		switch dt {
+		case datatype.String, datatype.Geoshape:
+			return true
+		case datatype.Character:
+			return utf8.RuneCountInString(v) == 1
+		}
+		return !checkedDataType(dt)
+	case bool:
+		return dt == datatype.Boolean || !checkedDataType(dt)
+	case geoshape.Geoshape:
+		return dt == datatype.Geoshape || !checkedDataType(dt)
+	}
+
+	n, integer, ok := number(value)
+	if !ok {
+		// Nothing is known about the value, so the server decides.
+		return true
+	}
+
+	switch dt {
+	case datatype.Byte:
+		return integer && n >= math.MinInt8 && n <= math.MaxInt8
+	case datatype.Short:
+		return integer && n >= math.MinInt16 && n <= math.MaxInt16
+	case datatype.Integer:
+		return integer && n >= math.MinInt32 && n <= math.MaxInt32
+	case datatype.Long:
+		return integer
+	case datatype.Float, datatype.Double, datatype.Decimal, datatype.Precision:
+		return true
+	}
+
+	return !checkedDataType(dt)
+}
+
+// checkedDataType reports whether values of the data type are checked.
+func checkedDataType(dt datatype.DataType) bool {
+	switch dt {
+	case datatype.String, datatype.Character, datatype.Boolean,
+		datatype.Byte, datatype.Short, datatype.Integer, datatype.Long,
+		datatype.Float, datatype.Double, datatype.Decimal, datatype.Precision,
+		datatype.Geoshape:
+		return true
+	}
+	return false
+}
+
+// number reports whether the value is a number and, when it's a
+// whole number that fits in an int64, returns it as an integer.
+func number(value interface{}) (n int64, integer, ok bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true, true
+	case int8:
+		return int64(v), true, true
+	case int16:
+		return int64(v), true, true
+	case int32:
+		return int64(v), true, true
+	case int64:
+		return v, true, true
+	case uint:
+		return unsigned(uint64(v))
+	case uint8:
+		return int64(v), true, true
+	case uint16:
+		return int64(v), true, true
+	case uint32:
+		return int64(v), true, true
+	case uint64:
+		return unsigned(v)
+	case float32:
+		return float(float64(v))
+	case float64:
+		return float(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, true, true
+		}
+		if f, err := v.Float64(); err == nil {
+			return float(f)
+		}
+	}
+	return 0, false, false
+}
+
+func unsigned(v uint64) (int64, bool, bool) {
+	if v > math.MaxInt64 {
+		return 0, false, true
+	}
+	return int64(v), true, true
+}
+
+// float returns a float as an integer when it's a whole number,
+// since the traversal renders it without a decimal point.
+func float(v float64) (int64, bool, bool) {
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return 0, false, true
+	}
+	return int64(v), true, true
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case geoshape.Geoshape:
+		return "Geoshape"
+	}
+	return "number"
+}
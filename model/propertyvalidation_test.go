@@ -0,0 +1,139 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/geoshape"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+type validatingClient struct {
+	err      error
+	executed bool
+}
+
+func (c *validatingClient) ExecuteQuery(query.Query) ([][]byte, error) {
+	c.executed = true
+	return nil, nil
+}
+
+func (c *validatingClient) ExecuteStringQuery(string) ([][]byte, error) {
+	c.executed = true
+	return nil, nil
+}
+
+func (c *validatingClient) ValidateProperties(keyAndVals ...interface{}) error {
+	return c.err
+}
+
+func TestPropertyKeyValidate(t *testing.T) {
+	Convey("Given a SINGLE Long property key", t, func() {
+		k := PropertyKey{Name: "age", DataType: datatype.Long, Cardinality: cardinality.Single}
+		Convey("When Validate is called with a whole number", func() {
+			Convey("Then no error should be returned", func() {
+				So(k.Validate(int64(math.MaxInt64)), ShouldBeNil)
+				So(k.Validate(uint8(3)), ShouldBeNil)
+				So(k.Validate(float64(3)), ShouldBeNil)
+				So(k.Validate(json.Number("12")), ShouldBeNil)
+			})
+		})
+		Convey("When Validate is called with a string", func() {
+			err := k.Validate("twelve")
+			Convey("Then a property error should be returned", func() {
+				So(err, ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+				So(err.Error(), ShouldContainSubstring, `"key":"age"`)
+				So(err.Error(), ShouldContainSubstring, "is not a Long.class")
+			})
+		})
+		Convey("When Validate is called with numbers that aren't a Long", func() {
+			Convey("Then errors should be returned", func() {
+				So(k.Validate(1.5), ShouldNotBeNil)
+				So(k.Validate(uint64(math.MaxUint64)), ShouldNotBeNil)
+				So(k.Validate(math.Inf(1)), ShouldNotBeNil)
+			})
+		})
+		Convey("When Validate is called with multiple values", func() {
+			err := k.Validate(1, 2)
+			Convey("Then a property error should be returned", func() {
+				So(err, ShouldHaveSameTypeAs, &gremerror.PropertyError{})
+				So(err.Error(), ShouldContainSubstring, "SINGLE cardinality")
+			})
+		})
+		Convey("When Validate is called with a traversal", func() {
+			Convey("Then it should be left to the server", func() {
+				So(k.Validate(traversal.NewTraversal().V().Count()), ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a LIST Integer property key", t, func() {
+		k := PropertyKey{Name: "scores", DataType: datatype.Integer, Cardinality: cardinality.List}
+		Convey("When Validate is called with multiple values", func() {
+			Convey("Then values in range should be allowed", func() {
+				So(k.Validate(1, 2, math.MaxInt32), ShouldBeNil)
+				So(k.Validate(1, math.MaxInt32+1), ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given property keys of other data types", t, func() {
+		Convey("Then values should be checked against each data type", func() {
+			So(PropertyKey{DataType: datatype.String}.Validate("a"), ShouldBeNil)
+			So(PropertyKey{DataType: datatype.String}.Validate(1), ShouldNotBeNil)
+			So(PropertyKey{DataType: datatype.Character}.Validate("é"), ShouldBeNil)
+			So(PropertyKey{DataType: datatype.Character}.Validate("ab"), ShouldNotBeNil)
+			So(PropertyKey{DataType: datatype.Boolean}.Validate(true), ShouldBeNil)
+			So(PropertyKey{DataType: datatype.Boolean}.Validate("true"), ShouldNotBeNil)
+			So(PropertyKey{DataType: datatype.Byte}.Validate(-128), ShouldBeNil)
+			So(PropertyKey{DataType: datatype.Byte}.Validate(128), ShouldNotBeNil)
+			So(PropertyKey{DataType: datatype.Short}.Validate(70000), ShouldNotBeNil)
+			So(PropertyKey{DataType: datatype.Double}.Validate(1), ShouldBeNil)
+			So(PropertyKey{DataType: datatype.Double}.Validate(false), ShouldNotBeNil)
+			So(PropertyKey{DataType: datatype.Geoshape}.Validate(geoshape.NewPoint(1, 2)), ShouldBeNil)
+			So(PropertyKey{DataType: datatype.Geoshape}.Validate(3), ShouldNotBeNil)
+			So(PropertyKey{DataType: datatype.Long}.Validate(geoshape.NewPoint(1, 2)), ShouldNotBeNil)
+			So(PropertyKey{DataType: "Date.class"}.Validate("2018-01-01"), ShouldBeNil)
+		})
+	})
+}
+
+func TestAddPropertyValidation(t *testing.T) {
+	Convey("Given a vertex and a client that validates properties", t, func() {
+		v := Vertex{Type: "g:Vertex"}
+		client := &validatingClient{err: gremerror.NewPropertyError("age", "twelve", "invalid")}
+		Convey("When AddProperty is called with an invalid value", func() {
+			err := v.AddProperty(client, "age", "twelve")
+			Convey("Then the error should be returned without a query", func() {
+				So(err, ShouldEqual, client.err)
+				So(client.executed, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -26,3 +26,9 @@ type queryClient interface {
 	ExecuteQuery(query.Query) ([][]byte, error)
 	ExecuteStringQuery(string) ([][]byte, error)
 }
+
+// propertyValidator is implemented by clients that can check
+// property values against the schema before they're sent.
+type propertyValidator interface {
+	ValidateProperties(keyAndVals ...interface{}) error
+}
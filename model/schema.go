@@ -0,0 +1,122 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// JanusGraph: https://docs.janusgraph.org/schema/
+
+// PropertyKey is a property key defined in the schema.
+type PropertyKey struct {
+	Name        string                  `json:"name" yaml:"name"`
+	DataType    datatype.DataType       `json:"dataType" yaml:"dataType"`
+	Cardinality cardinality.Cardinality `json:"cardinality" yaml:"cardinality"`
+}
+
+// EdgeLabel is an edge label defined in the schema.
+// Directed is only read from the graph; every edge
+// label that isn't unidirected is directed.
+type EdgeLabel struct {
+	Name         string                    `json:"name" yaml:"name"`
+	Multiplicity multiplicity.Multiplicity `json:"multiplicity" yaml:"multiplicity"`
+	Directed     bool                      `json:"directed" yaml:"directed"`
+	Unidirected  bool                      `json:"unidirected" yaml:"unidirected"`
+}
+
+// VertexLabel is a vertex label defined in the schema.
+type VertexLabel struct {
+	Name        string `json:"name" yaml:"name"`
+	Partitioned bool   `json:"partitioned" yaml:"partitioned"`
+	Static      bool   `json:"static" yaml:"static"`
+}
+
+// IndexType is the kind of an index.
+type IndexType string
+
+const (
+	// CompositeIndex is a graph index stored by the storage backend.
+	CompositeIndex IndexType = "composite"
+	// MixedIndex is a graph index stored by an indexing backend.
+	MixedIndex IndexType = "mixed"
+	// EdgeIndex is a vertex-centric index of an edge label.
+	EdgeIndex IndexType = "vertex-centric"
+)
+
+// Index is a graph or vertex-centric index defined in the schema.
+// A defined index without a type is a mixed index when it has a
+// backend, a vertex-centric index when it has an edge label and a
+// composite index otherwise.
+type Index struct {
+	Name string    `json:"name" yaml:"name"`
+	Type IndexType `json:"type" yaml:"type"`
+	// Element is what a graph index is built for.
+	Element index.Element `json:"element,omitempty" yaml:"element,omitempty"`
+	Unique  bool          `json:"unique" yaml:"unique"`
+	// Backend is the indexing backend of a mixed index.
+	Backend string `json:"backend,omitempty" yaml:"backend,omitempty"`
+	// Label is the edge label of a vertex-centric index.
+	Label string `json:"label,omitempty" yaml:"label,omitempty"`
+	// Direction and Order are the direction and the sort order
+	// of a vertex-centric index. They default to BOTH and Order.asc.
+	Direction direction.Direction `json:"direction,omitempty" yaml:"direction,omitempty"`
+	Order     order.Order         `json:"order,omitempty" yaml:"order,omitempty"`
+	Keys      []IndexKey          `json:"keys" yaml:"keys"`
+}
+
+// IndexKey is a property key of an index
+// with the index's status for that key.
+type IndexKey struct {
+	Name   string       `json:"name" yaml:"name"`
+	Status index.Status `json:"status,omitempty" yaml:"status,omitempty"`
+	// Mapping is only used when a mixed index is defined.
+	Mapping index.Mapping `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+}
+
+// Status is the status of the index, which is
+// the status of its first key that isn't enabled.
+func (i Index) Status() index.Status {
+	for _, k := range i.Keys {
+		if k.Status != index.Enabled {
+			return k.Status
+		}
+	}
+
+	if len(i.Keys) == 0 {
+		return ""
+	}
+
+	return index.Enabled
+}
+
+// Connection is an edge label that is allowed
+// between two vertex labels in the schema.
+type Connection struct {
+	EdgeLabel      string `json:"edgeLabel" yaml:"edgeLabel"`
+	OutVertexLabel string `json:"outVertexLabel" yaml:"outVertexLabel"`
+	InVertexLabel  string `json:"inVertexLabel" yaml:"inVertexLabel"`
+}
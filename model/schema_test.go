@@ -0,0 +1,53 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/index"
+)
+
+func TestIndexStatus(t *testing.T) {
+	Convey("Given an index from the schema", t, func() {
+		Convey("When every key is enabled", func() {
+			i := Index{Keys: []IndexKey{{Name: "a", Status: index.Enabled}, {Name: "b", Status: index.Enabled}}}
+			Convey("Then the index should be enabled", func() {
+				So(i.Status(), ShouldEqual, index.Enabled)
+			})
+		})
+
+		Convey("When a key isn't enabled", func() {
+			i := Index{Keys: []IndexKey{{Name: "a", Status: index.Enabled}, {Name: "b", Status: index.Installed}}}
+			Convey("Then the index should have that key's status", func() {
+				So(i.Status(), ShouldEqual, index.Installed)
+			})
+		})
+
+		Convey("When the index has no keys", func() {
+			Convey("Then the index should have no status", func() {
+				So(Index{}.Status(), ShouldEqual, index.Status(""))
+			})
+		})
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// Schema is the declarative definition of a graph's
+// schema. It can be written in Go or loaded from YAML
+// or JSON with UnmarshalSchema:
+//
+//	propertyKeys:
+//	  - name: name
+//	    dataType: String.class
+//	vertexLabels:
+//	  - name: person
+//	edgeLabels:
+//	  - name: knows
+//	    multiplicity: MULTI
+//	connections:
+//	  - edgeLabel: knows
+//	    outVertexLabel: person
+//	    inVertexLabel: person
+//	indexes:
+//	  - name: byName
+//	    element: Vertex.class
+//	    unique: true
+//	    keys:
+//	      - name: name
+type Schema struct {
+	PropertyKeys []PropertyKey `json:"propertyKeys,omitempty" yaml:"propertyKeys,omitempty"`
+	VertexLabels []VertexLabel `json:"vertexLabels,omitempty" yaml:"vertexLabels,omitempty"`
+	EdgeLabels   []EdgeLabel   `json:"edgeLabels,omitempty" yaml:"edgeLabels,omitempty"`
+	Connections  []Connection  `json:"connections,omitempty" yaml:"connections,omitempty"`
+	Indexes      []Index       `json:"indexes,omitempty" yaml:"indexes,omitempty"`
+}
+
+// UnmarshalSchema is a utility to unmarshal a schema
+// definition written in either YAML or JSON.
+func UnmarshalSchema(data []byte) (Schema, error) {
+	var s Schema
+	if err := yaml.UnmarshalStrict(data, &s); err != nil {
+		return Schema{}, gremerror.NewUnmarshalError("UnmarshalSchema", data, err)
+	}
+
+	return s, nil
+}
+
+// SchemaConflict is a schema type that is defined
+// differently than it already exists in the graph.
+type SchemaConflict struct {
+	// Kind is the kind of schema type, such as "property key".
+	Kind  string
+	Name  string
+	Field string
+	Want  string
+	Have  string
+}
+
+func (c SchemaConflict) String() string {
+	return fmt.Sprintf("%s %q has %s %q but %q is defined", c.Kind, c.Name, c.Field, c.Have, c.Want)
+}
+
+// SchemaDiff holds the schema types that are defined
+// but missing from the graph, and the conflicts between
+// the definitions and the types that already exist.
+type SchemaDiff struct {
+	Missing   Schema
+	Conflicts []SchemaConflict
+}
+
+// Empty tells whether the graph already
+// matches the schema definition.
+func (d SchemaDiff) Empty() bool {
+	m := d.Missing
+	return len(m.PropertyKeys) == 0 && len(m.VertexLabels) == 0 && len(m.EdgeLabels) == 0 &&
+		len(m.Connections) == 0 && len(m.Indexes) == 0 && len(d.Conflicts) == 0
+}
+
+// Err returns an error that lists the
+// conflicts, or nil when there are none.
+func (d SchemaDiff) Err() error {
+	if len(d.Conflicts) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(d.Conflicts))
+	for _, c := range d.Conflicts {
+		msgs = append(msgs, c.String())
+	}
+
+	return fmt.Errorf("%w: %s", gremerror.ErrSchemaConflict, strings.Join(msgs, "; "))
+}
+
+// Diff compares the schema definition to the schema
+// that exists in the graph. Types that exist with the
+// same name but different settings are conflicts.
+func (s Schema) Diff(existing Schema) SchemaDiff {
+	var d SchemaDiff
+
+	keys := make(map[string]PropertyKey)
+	for _, k := range existing.PropertyKeys {
+		keys[k.Name] = k
+	}
+	for _, k := range s.PropertyKeys {
+		k = k.withDefaults()
+		have, ok := keys[k.Name]
+		if !ok {
+			d.Missing.PropertyKeys = append(d.Missing.PropertyKeys, k)
+			continue
+		}
+		d.conflict("property key", k.Name, "data type", k.DataType.String(), have.DataType.String())
+		d.conflict("property key", k.Name, "cardinality", k.Cardinality.String(), have.Cardinality.String())
+	}
+
+	vertexLabels := make(map[string]VertexLabel)
+	for _, l := range existing.VertexLabels {
+		vertexLabels[l.Name] = l
+	}
+	for _, l := range s.VertexLabels {
+		have, ok := vertexLabels[l.Name]
+		if !ok {
+			d.Missing.VertexLabels = append(d.Missing.VertexLabels, l)
+			continue
+		}
+		d.conflict("vertex label", l.Name, "partitioned", fmt.Sprint(l.Partitioned), fmt.Sprint(have.Partitioned))
+		d.conflict("vertex label", l.Name, "static", fmt.Sprint(l.Static), fmt.Sprint(have.Static))
+	}
+
+	edgeLabels := make(map[string]EdgeLabel)
+	for _, l := range existing.EdgeLabels {
+		edgeLabels[l.Name] = l
+	}
+	for _, l := range s.EdgeLabels {
+		l = l.withDefaults()
+		have, ok := edgeLabels[l.Name]
+		if !ok {
+			d.Missing.EdgeLabels = append(d.Missing.EdgeLabels, l)
+			continue
+		}
+		d.conflict("edge label", l.Name, "multiplicity", l.Multiplicity.String(), have.Multiplicity.String())
+		d.conflict("edge label", l.Name, "unidirected", fmt.Sprint(l.Unidirected), fmt.Sprint(have.Unidirected))
+	}
+
+	connections := make(map[Connection]bool)
+	for _, c := range existing.Connections {
+		connections[c] = true
+	}
+	for _, c := range s.Connections {
+		if !connections[c] {
+			d.Missing.Connections = append(d.Missing.Connections, c)
+		}
+	}
+
+	indexes := make(map[string]Index)
+	for _, i := range existing.Indexes {
+		indexes[i.Name] = i
+	}
+	for _, i := range s.Indexes {
+		i = i.withDefaults()
+		have, ok := indexes[i.Name]
+		if !ok {
+			d.Missing.Indexes = append(d.Missing.Indexes, i)
+			continue
+		}
+		d.conflict("index", i.Name, "type", string(i.Type), string(have.Type))
+		d.conflict("index", i.Name, "element", i.Element.String(), have.Element.String())
+		d.conflict("index", i.Name, "unique", fmt.Sprint(i.Unique), fmt.Sprint(have.Unique))
+		d.conflict("index", i.Name, "backend", i.Backend, have.Backend)
+		d.conflict("index", i.Name, "label", i.Label, have.Label)
+		d.conflict("index", i.Name, "direction", i.Direction.String(), have.Direction.String())
+		d.conflict("index", i.Name, "order", i.Order.String(), have.Order.String())
+		d.conflict("index", i.Name, "keys", keyNames(i.Keys), keyNames(have.Keys))
+	}
+
+	return d
+}
+
+func (d *SchemaDiff) conflict(kind, name, field, want, have string) {
+	if want != have {
+		d.Conflicts = append(d.Conflicts, SchemaConflict{
+			Kind:  kind,
+			Name:  name,
+			Field: field,
+			Want:  want,
+			Have:  have,
+		})
+	}
+}
+
+func (k PropertyKey) withDefaults() PropertyKey {
+	if k.Cardinality == "" {
+		k.Cardinality = cardinality.Single
+	}
+	return k
+}
+
+func (l EdgeLabel) withDefaults() EdgeLabel {
+	if l.Multiplicity == "" {
+		l.Multiplicity = multiplicity.Multi
+	}
+	return l
+}
+
+func (i Index) withDefaults() Index {
+	if i.Type == "" {
+		i.Type = CompositeIndex
+		switch {
+		case i.Backend != "":
+			i.Type = MixedIndex
+		case i.Label != "":
+			i.Type = EdgeIndex
+		}
+	}
+	if i.Type == EdgeIndex {
+		if i.Direction == "" {
+			i.Direction = direction.Both
+		}
+		if i.Order == "" {
+			i.Order = order.Asc
+		}
+	}
+	return i
+}
+
+func keyNames(keys []IndexKey) string {
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		names = append(names, k.Name)
+	}
+	return strings.Join(names, ",")
+}
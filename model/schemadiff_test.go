@@ -0,0 +1,178 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+const schemaYAML = `
+propertyKeys:
+  - name: name
+    dataType: String.class
+  - name: bio
+    dataType: String.class
+vertexLabels:
+  - name: person
+    static: true
+edgeLabels:
+  - name: knows
+connections:
+  - edgeLabel: knows
+    outVertexLabel: person
+    inVertexLabel: person
+indexes:
+  - name: byName
+    element: Vertex.class
+    unique: true
+    keys:
+      - name: name
+  - name: search
+    element: Vertex.class
+    backend: search
+    keys:
+      - name: bio
+        mapping: Mapping.TEXT
+`
+
+func TestUnmarshalSchema(t *testing.T) {
+	Convey("Given a schema definition", t, func() {
+		Convey("When 'UnmarshalSchema' is called with YAML", func() {
+			s, err := UnmarshalSchema([]byte(schemaYAML))
+			Convey("Then every schema type should be unmarshalled", func() {
+				So(err, ShouldBeNil)
+				So(s.PropertyKeys, ShouldResemble, []PropertyKey{{Name: "name", DataType: datatype.String}, {Name: "bio", DataType: datatype.String}})
+				So(s.VertexLabels, ShouldResemble, []VertexLabel{{Name: "person", Static: true}})
+				So(s.EdgeLabels, ShouldResemble, []EdgeLabel{{Name: "knows"}})
+				So(s.Connections, ShouldResemble, []Connection{{EdgeLabel: "knows", OutVertexLabel: "person", InVertexLabel: "person"}})
+				So(s.Indexes, ShouldHaveLength, 2)
+				So(s.Indexes[1].Keys, ShouldResemble, []IndexKey{{Name: "bio", Mapping: index.Text}})
+			})
+		})
+
+		Convey("When 'UnmarshalSchema' is called with JSON", func() {
+			s, err := UnmarshalSchema([]byte(`{"propertyKeys":[{"name":"age","dataType":"Integer.class","cardinality":"LIST"}]}`))
+			Convey("Then the schema should be unmarshalled", func() {
+				So(err, ShouldBeNil)
+				So(s.PropertyKeys, ShouldResemble, []PropertyKey{{Name: "age", DataType: datatype.Integer, Cardinality: cardinality.List}})
+			})
+		})
+
+		Convey("When 'UnmarshalSchema' is called with an unknown field", func() {
+			_, err := UnmarshalSchema([]byte("propertyKey:\n  - name: age\n"))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSchemaDiff(t *testing.T) {
+	Convey("Given a schema definition", t, func() {
+		definition, _ := UnmarshalSchema([]byte(schemaYAML))
+		Convey("When it is compared to an empty graph", func() {
+			d := definition.Diff(Schema{})
+			Convey("Then every type should be missing with its defaults", func() {
+				So(d.Conflicts, ShouldBeEmpty)
+				So(d.Empty(), ShouldBeFalse)
+				So(d.Err(), ShouldBeNil)
+				So(d.Missing.PropertyKeys[0].Cardinality, ShouldEqual, cardinality.Single)
+				So(d.Missing.EdgeLabels[0].Multiplicity, ShouldEqual, multiplicity.Multi)
+				So(d.Missing.Indexes[0].Type, ShouldEqual, CompositeIndex)
+				So(d.Missing.Indexes[1].Type, ShouldEqual, MixedIndex)
+				So(d.Missing.Connections, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When it is compared to a graph that matches it", func() {
+			existing := Schema{
+				PropertyKeys: []PropertyKey{{Name: "name", DataType: datatype.String, Cardinality: cardinality.Single}, {Name: "bio", DataType: datatype.String, Cardinality: cardinality.Single}},
+				VertexLabels: []VertexLabel{{Name: "person", Static: true}},
+				EdgeLabels:   []EdgeLabel{{Name: "knows", Multiplicity: multiplicity.Multi, Directed: true}},
+				Connections:  []Connection{{EdgeLabel: "knows", OutVertexLabel: "person", InVertexLabel: "person"}},
+				Indexes: []Index{
+					{Name: "byName", Type: CompositeIndex, Element: index.Vertex, Unique: true, Keys: []IndexKey{{Name: "name", Status: index.Enabled}}},
+					{Name: "search", Type: MixedIndex, Element: index.Vertex, Backend: "search", Keys: []IndexKey{{Name: "bio", Status: index.Enabled}}},
+				},
+			}
+			d := definition.Diff(existing)
+			Convey("Then the diff should be empty", func() {
+				So(d.Empty(), ShouldBeTrue)
+			})
+		})
+
+		Convey("When it is compared to a graph with different types", func() {
+			existing := Schema{
+				PropertyKeys: []PropertyKey{{Name: "name", DataType: datatype.Integer, Cardinality: cardinality.Single}},
+				EdgeLabels:   []EdgeLabel{{Name: "knows", Multiplicity: multiplicity.Simple}},
+				Indexes:      []Index{{Name: "byName", Type: CompositeIndex, Element: index.Vertex, Keys: []IndexKey{{Name: "name"}}}},
+			}
+			d := definition.Diff(existing)
+			Convey("Then the differences should be conflicts", func() {
+				So(d.Conflicts, ShouldResemble, []SchemaConflict{
+					{Kind: "property key", Name: "name", Field: "data type", Want: "String.class", Have: "Integer.class"},
+					{Kind: "edge label", Name: "knows", Field: "multiplicity", Want: "MULTI", Have: "SIMPLE"},
+					{Kind: "index", Name: "byName", Field: "unique", Want: "true", Have: "false"},
+				})
+			})
+			Convey("Then the error should list the conflicts", func() {
+				err := d.Err()
+				So(errors.Is(err, gremerror.ErrSchemaConflict), ShouldBeTrue)
+				So(err.Error(), ShouldContainSubstring, `property key "name" has data type "Integer.class" but "String.class" is defined`)
+			})
+		})
+	})
+
+	Convey("Given an edge index defined without a direction or order", t, func() {
+		definition := Schema{Indexes: []Index{{Name: "byTime", Label: "knows", Keys: []IndexKey{{Name: "time"}}}}}
+		Convey("When it is compared to an empty graph", func() {
+			d := definition.Diff(Schema{})
+			Convey("Then it should be missing as an edge index in both directions in ascending order", func() {
+				So(d.Missing.Indexes, ShouldResemble, []Index{{
+					Name: "byTime", Type: EdgeIndex, Label: "knows",
+					Direction: direction.Both, Order: order.Asc, Keys: []IndexKey{{Name: "time"}},
+				}})
+			})
+		})
+
+		Convey("When it is compared to the index read back from the graph", func() {
+			existing := Schema{Indexes: []Index{{
+				Name: "byTime", Type: EdgeIndex, Label: "knows",
+				Direction: direction.Both, Order: order.Asc, Keys: []IndexKey{{Name: "time", Status: index.Enabled}},
+			}}}
+			d := definition.Diff(existing)
+			Convey("Then the diff should be empty", func() {
+				So(d.Empty(), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -21,7 +21,10 @@
 package model
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"strconv"
 
 	"github.com/northwesternmutual/grammes/gremerror"
 )
@@ -93,3 +96,83 @@ func UnmarshalPropertyList(data [][]byte) ([]Property, error) {
 
 	return list, nil
 }
+
+// UnmarshalValues is a utility to unmarshal the results
+// of a traversal, such as an OLAP job, into plain values.
+// GraphSON types are removed and maps are keyed by strings
+// so v may be a pointer to any slice json.Unmarshal can fill.
+func UnmarshalValues(data [][]byte, v interface{}) error {
+	list := []interface{}{}
+
+	for _, res := range data {
+		var raw interface{}
+
+		dec := json.NewDecoder(bytes.NewReader(res))
+		dec.UseNumber()
+		if err := dec.Decode(&raw); err != nil {
+			return gremerror.NewUnmarshalError("UnmarshalValues", res, err)
+		}
+
+		if part, ok := untype(raw).([]interface{}); ok {
+			list = append(list, part...)
+		} else {
+			list = append(list, untype(raw))
+		}
+	}
+
+	plain, err := json.Marshal(list)
+	if err != nil {
+		return gremerror.NewUnmarshalError("UnmarshalValues", plain, err)
+	}
+
+	if err = json.Unmarshal(plain, v); err != nil {
+		return gremerror.NewUnmarshalError("UnmarshalValues", plain, err)
+	}
+
+	return nil
+}
+
+// untype removes the GraphSON @type and @value wrappers
+// from a decoded value. Maps are stored as a list of keys
+// and values and bulk sets as a list of items and counts.
+func untype(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		val, ok := t["@value"]
+		typ, typed := t["@type"].(string)
+		if !ok || !typed {
+			for k, val := range t {
+				t[k] = untype(val)
+			}
+			return t
+		}
+
+		pairs, isList := val.([]interface{})
+		switch {
+		case typ == "g:Map" && isList:
+			m := make(map[string]interface{}, len(pairs)/2)
+			for i := 0; i+1 < len(pairs); i += 2 {
+				m[fmt.Sprint(untype(pairs[i]))] = untype(pairs[i+1])
+			}
+			return m
+		case typ == "g:BulkSet" && isList:
+			var items []interface{}
+			for i := 0; i+1 < len(pairs); i += 2 {
+				n, _ := strconv.Atoi(fmt.Sprint(untype(pairs[i+1])))
+				for j := 0; j < n; j++ {
+					items = append(items, untype(pairs[i]))
+				}
+			}
+			return items
+		default:
+			return untype(val)
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = untype(val)
+		}
+		return t
+	default:
+		return v
+	}
+}
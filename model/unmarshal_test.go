@@ -0,0 +1,87 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnmarshalValues(t *testing.T) {
+	Convey("Given the responses of a traversal", t, func() {
+		Convey("When 'UnmarshalValues' is called with GraphSON maps", func() {
+			data := [][]byte{
+				[]byte(`{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","marko",{"@type":"g:T","@value":"id"},{"@type":"g:Int64","@value":1},"rank",{"@type":"g:Double","@value":0.15}]}]}`),
+				[]byte(`{"@type":"g:List","@value":[{"@type":"g:Map","@value":["name","josh",{"@type":"g:T","@value":"id"},{"@type":"g:Int64","@value":4},"rank",{"@type":"g:Double","@value":0.19}]}]}`),
+			}
+			var results []struct {
+				ID   int64   `json:"id"`
+				Name string  `json:"name"`
+				Rank float64 `json:"rank"`
+			}
+			err := UnmarshalValues(data, &results)
+			Convey("Then every response should be decoded", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldHaveLength, 2)
+				So(results[0].ID, ShouldEqual, 1)
+				So(results[0].Name, ShouldEqual, "marko")
+				So(results[1].Rank, ShouldEqual, 0.19)
+			})
+		})
+
+		Convey("When 'UnmarshalValues' is called with a bulk set", func() {
+			data := [][]byte{[]byte(`{"@type":"g:BulkSet","@value":["a",{"@type":"g:Int64","@value":2},"b",{"@type":"g:Int64","@value":1}]}`)}
+			var results []string
+			err := UnmarshalValues(data, &results)
+			Convey("Then the items should be repeated by their counts", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, []string{"a", "a", "b"})
+			})
+		})
+
+		Convey("When 'UnmarshalValues' is called with a single value", func() {
+			data := [][]byte{[]byte(`{"@type":"g:Int64","@value":12345678901234567}`)}
+			var results []int64
+			err := UnmarshalValues(data, &results)
+			Convey("Then the value should keep its precision", func() {
+				So(err, ShouldBeNil)
+				So(results, ShouldResemble, []int64{12345678901234567})
+			})
+		})
+
+		Convey("When 'UnmarshalValues' is called with invalid JSON", func() {
+			var results []interface{}
+			err := UnmarshalValues([][]byte{[]byte(`{`)}, &results)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When 'UnmarshalValues' is called with the wrong type", func() {
+			var results []int
+			err := UnmarshalValues([][]byte{[]byte(`["a"]`)}, &results)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
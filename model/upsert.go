@@ -0,0 +1,53 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package model
+
+// VertexUpsert is a vertex to find by its label and match
+// properties, or to create when there isn't one. The Set
+// properties are set on the vertex either way.
+type VertexUpsert struct {
+	Label string
+	Match map[string]interface{}
+	Set   map[string]interface{}
+}
+
+// EdgeUpsert is an edge with the label to find between
+// the vertices, or to create when there isn't one. The
+// Properties are set on the edge either way.
+type EdgeUpsert struct {
+	OutID      int64
+	InID       int64
+	Label      string
+	Properties map[string]interface{}
+}
+
+// UpsertedVertex is the vertex found or created
+// by an upsert and whether it was created.
+type UpsertedVertex struct {
+	Vertex  Vertex
+	Created bool
+}
+
+// UpsertedEdge is the edge found or created
+// by an upsert and whether it was created.
+type UpsertedEdge struct {
+	Edge    Edge
+	Created bool
+}
@@ -205,6 +205,12 @@ func (v *Vertex) AddProperty(client queryClient, key string, value interface{})
 		return gremerror.NewGrammesError("AddProperty", gremerror.ErrNilClient)
 	}
 
+	if validator, ok := client.(propertyValidator); ok {
+		if err := validator.ValidateProperties(key, value); err != nil {
+			return err
+		}
+	}
+
 	_, err := client.ExecuteQuery(newTrav().V().HasID(v.ID()).Property(key, value))
 	if err != nil {
 		return err
@@ -20,7 +20,11 @@
 
 package model
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/northwesternmutual/grammes/query/geoshape"
+)
 
 // VertexValue contains the 'value' data
 // from the Vertex object.
@@ -52,6 +56,14 @@ type ValueWrapper struct {
 // Value into the variables within the struct.
 func (w *ValueWrapper) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &w.PropertyDetailedValue); err == nil {
+		// geoshapes are decoded into a geoshape.Geoshape.
+		if w.Type == geoshape.GraphSONType {
+			var shape geoshape.Geoshape
+			if err = json.Unmarshal(data, &shape); err != nil {
+				return err
+			}
+			w.Value = shape
+		}
 		return nil
 	}
 
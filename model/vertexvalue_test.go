@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/geoshape"
 )
 
 func TestUnmarshalJSON(t *testing.T) {
@@ -41,3 +43,33 @@ func TestUnmarshalJSON(t *testing.T) {
 		})
 	})
 }
+
+func TestUnmarshalJSONGeoshape(t *testing.T) {
+	Convey("Given a ValueWrapper", t, func() {
+		var vw ValueWrapper
+		Convey("When 'UnmarshalJSON' is called with a janusgraph:Geoshape", func() {
+			err := vw.UnmarshalJSON([]byte(`{
+				"@type": "janusgraph:Geoshape",
+				"@value": {
+					"type": "Point",
+					"coordinates": [
+						{"@type": "g:Double", "@value": -93.2},
+						{"@type": "g:Double", "@value": 44.9}
+					]
+				}
+			}`))
+			Convey("Then the value should be a geoshape.Geoshape", func() {
+				So(err, ShouldBeNil)
+				So(vw.Type, ShouldEqual, geoshape.GraphSONType)
+				So(vw.Value, ShouldResemble, geoshape.NewPoint(44.9, -93.2))
+			})
+		})
+
+		Convey("When 'UnmarshalJSON' is called with an invalid janusgraph:Geoshape", func() {
+			err := vw.UnmarshalJSON([]byte(`{"@type": "janusgraph:Geoshape", "@value": {"type": "Hexagon"}}`))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package computer contains the object to configure OLAP traversals with withComputer().
+
+See: https://tinkerpop.apache.org/docs/current/reference/#graphcomputer
+
+A Computer chooses the GraphComputer that runs a traversal and how
+many workers it uses, what it keeps in the graph and which graph
+its results are written to:
+
+	computer.Compute(computer.SparkGraphComputer).Workers(4).Persist(computer.Edges)
+
+A note about Computer:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package computer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// Class is the GraphComputer implementation
+// that runs the traversal.
+type Class string
+
+const (
+	// SparkGraphComputer runs the traversal on Spark through Hadoop-Gremlin.
+	SparkGraphComputer Class = "SparkGraphComputer"
+	// TinkerGraphComputer runs the traversal in memory on TinkerGraph.
+	TinkerGraphComputer Class = "TinkerGraphComputer"
+	// FulgoraGraphComputer runs the traversal in memory on JanusGraph.
+	FulgoraGraphComputer Class = "FulgoraGraphComputer"
+)
+
+func (c Class) String() string {
+	return string(c)
+}
+
+// Persist is what the computer
+// keeps in the resulting graph.
+type Persist string
+
+const (
+	// Nothing keeps no part of the graph.
+	Nothing Persist = "GraphComputer.Persist.NOTHING"
+	// VertexProperties keeps the vertices and their properties.
+	VertexProperties Persist = "GraphComputer.Persist.VERTEX_PROPERTIES"
+	// Edges keeps the whole graph.
+	Edges Persist = "GraphComputer.Persist.EDGES"
+)
+
+func (p Persist) String() string {
+	return string(p)
+}
+
+// ResultGraph is the graph the
+// computer writes its results to.
+type ResultGraph string
+
+const (
+	// Original writes the results back to the original graph.
+	Original ResultGraph = "GraphComputer.ResultGraph.ORIGINAL"
+	// New writes the results to a new graph.
+	New ResultGraph = "GraphComputer.ResultGraph.NEW"
+)
+
+func (r ResultGraph) String() string {
+	return string(r)
+}
+
+// Computer is the configuration given to withComputer().
+type Computer string
+
+// Compute returns a Computer that uses the given GraphComputer
+// class or the provider's default when there is none.
+func Compute(class ...Class) Computer {
+	if len(class) > 0 {
+		return Computer("Computer.compute(" + class[0].String() + ")")
+	}
+
+	return Computer("Computer.compute()")
+}
+
+// Workers sets the number of workers that run the traversal.
+func (c Computer) Workers(n int) Computer {
+	return c.add("workers", strconv.Itoa(n))
+}
+
+// Persist sets what is kept in the resulting graph.
+func (c Computer) Persist(p Persist) Computer {
+	return c.add("persist", p.String())
+}
+
+// Result sets the graph the results are written to.
+func (c Computer) Result(r ResultGraph) Computer {
+	return c.add("result", r.String())
+}
+
+// Vertices limits the vertices the computer loads
+// to those matched by the anonymous traversal.
+func (c Computer) Vertices(traversal fmt.Stringer) Computer {
+	return c.add("vertices", traversal.String())
+}
+
+// Edges limits the edges the computer loads
+// to those matched by the anonymous traversal.
+func (c Computer) Edges(traversal fmt.Stringer) Computer {
+	return c.add("edges", traversal.String())
+}
+
+// Configure sets a provider specific configuration
+// such as "spark.executor.memory".
+func (c Computer) Configure(key string, value interface{}) Computer {
+	return c.add("configure", literal.Arg(key)+","+literal.Arg(value))
+}
+
+func (c Computer) add(method, args string) Computer {
+	return Computer(string(c) + "." + method + "(" + args + ")")
+}
+
+func (c Computer) String() string {
+	return string(c)
+}
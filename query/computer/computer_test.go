@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package computer
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type anonymous string
+
+func (a anonymous) String() string { return string(a) }
+
+func TestCompute(t *testing.T) {
+	Convey("Given a computer configuration", t, func() {
+		Convey("When 'Compute' is called without a class", func() {
+			Convey("Then the provider's default computer should be used", func() {
+				So(Compute().String(), ShouldEqual, "Computer.compute()")
+			})
+		})
+
+		Convey("When 'Compute' is called with a class", func() {
+			Convey("Then the class should be rendered", func() {
+				So(Compute(SparkGraphComputer).String(), ShouldEqual, "Computer.compute(SparkGraphComputer)")
+			})
+		})
+
+		Convey("When 'Workers', 'Persist' and 'Result' are called", func() {
+			c := Compute(SparkGraphComputer).Workers(4).Persist(Edges).Result(New)
+			Convey("Then every option should be rendered in order", func() {
+				So(c.String(), ShouldEqual, "Computer.compute(SparkGraphComputer).workers(4).persist(GraphComputer.Persist.EDGES).result(GraphComputer.ResultGraph.NEW)")
+			})
+		})
+
+		Convey("When 'Vertices' and 'Edges' are called", func() {
+			c := Compute().Vertices(anonymous("__.hasLabel(\"person\")")).Edges(anonymous("__.bothE(\"knows\")"))
+			Convey("Then the traversals should be rendered", func() {
+				So(c.String(), ShouldEqual, "Computer.compute().vertices(__.hasLabel(\"person\")).edges(__.bothE(\"knows\"))")
+			})
+		})
+
+		Convey("When 'Configure' is called", func() {
+			c := Compute(SparkGraphComputer).Configure("spark.executor.memory", "4g").Configure("gremlin.spark.persistContext", true)
+			Convey("Then the key and value should be rendered", func() {
+				So(c.String(), ShouldEqual, "Computer.compute(SparkGraphComputer).configure(\"spark.executor.memory\",\"4g\").configure(\"gremlin.spark.persistContext\",true)")
+			})
+		})
+
+		Convey("When 'Configure' is called with a dollar sign", func() {
+			c := Compute().Configure("spark.app.name", "${name}")
+			Convey("Then the dollar sign should be escaped", func() {
+				So(c.String(), ShouldEqual, `Computer.compute().configure("spark.app.name","\${name}")`)
+			})
+		})
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package computer
+
+// Option is a key given to with() to configure
+// a step that is backed by a VertexProgram.
+type Option string
+
+const (
+	// ConnectedComponentComponent is the default property
+	// that connectedComponent() writes the component to.
+	ConnectedComponentComponent Option = "ConnectedComponent.component"
+	// ConnectedComponentEdges is the anonymous traversal
+	// that selects the edges connectedComponent() follows.
+	ConnectedComponentEdges Option = "ConnectedComponent.edges"
+	// ConnectedComponentPropertyName is the property
+	// that connectedComponent() writes the component to.
+	ConnectedComponentPropertyName Option = "ConnectedComponent.propertyName"
+
+	// ShortestPathTarget is the filter traversal
+	// for the vertices that shortestPath() ends at.
+	ShortestPathTarget Option = "ShortestPath.target"
+	// ShortestPathEdges is the anonymous traversal or direction
+	// that selects the edges shortestPath() follows.
+	ShortestPathEdges Option = "ShortestPath.edges"
+	// ShortestPathDistance is the edge property or traversal
+	// that shortestPath() uses as the distance.
+	ShortestPathDistance Option = "ShortestPath.distance"
+	// ShortestPathMaxDistance is the longest distance of a path.
+	ShortestPathMaxDistance Option = "ShortestPath.maxDistance"
+	// ShortestPathIncludeEdges includes the edges in the paths.
+	ShortestPathIncludeEdges Option = "ShortestPath.includeEdges"
+
+	// PageRankEdges is the anonymous traversal
+	// that selects the edges pageRank() follows.
+	PageRankEdges Option = "PageRank.edges"
+	// PageRankTimes is the number of iterations pageRank() runs.
+	PageRankTimes Option = "PageRank.times"
+	// PageRankPropertyName is the property pageRank() writes the rank to.
+	PageRankPropertyName Option = "PageRank.propertyName"
+
+	// PeerPressureEdges is the anonymous traversal
+	// that selects the edges peerPressure() follows.
+	PeerPressureEdges Option = "PeerPressure.edges"
+	// PeerPressureTimes is the number of iterations peerPressure() runs.
+	PeerPressureTimes Option = "PeerPressure.times"
+	// PeerPressurePropertyName is the property
+	// peerPressure() writes the cluster to.
+	PeerPressurePropertyName Option = "PeerPressure.propertyName"
+)
+
+func (o Option) String() string {
+	return string(o)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package consistency contains the object to set how JanusGraph keeps schema elements consistent.
+
+See: https://docs.janusgraph.org/advanced-topics/eventual-consistency/
+
+A ConsistencyModifier is given to setConsistency() for a property key,
+edge label or index. LOCK enforces uniqueness with locks on eventually
+consistent storage backends and FORK avoids them for edges.
+
+A note about Modifier:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package consistency
+
+// Modifier is how the storage backend keeps
+// a schema element consistent.
+type Modifier string
+
+const (
+	// Default uses the storage backend's default consistency.
+	Default Modifier = "ConsistencyModifier.DEFAULT"
+	// Lock acquires locks to keep the element consistent.
+	Lock Modifier = "ConsistencyModifier.LOCK"
+	// Fork writes a new edge instead of changing the old one.
+	Fork Modifier = "ConsistencyModifier.FORK"
+)
+
+func (m Modifier) String() string {
+	return string(m)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package dialect contains the object to describe which Gremlin provider is being queried.
+
+JanusGraph, TinkerGraph, Amazon Neptune and Azure Cosmos DB each accept
+a different subset of Gremlin. A Dialect is used to adapt a traversal to
+the provider or to reject it with a clear error before it is sent.
+
+See:
+
+	Neptune: https://docs.aws.amazon.com/neptune/latest/userguide/access-graph-gremlin-differences.html
+	Cosmos DB: https://docs.microsoft.com/en-us/azure/cosmos-db/gremlin-support
+*/
+package dialect
+
+// Dialect is the Gremlin provider that traversals are rendered for.
+// The zero value is a generic provider which accepts every step.
+type Dialect string
+
+const (
+	// Generic renders traversals as they are built.
+	Generic Dialect = ""
+	// JanusGraph accepts every step and the management API.
+	JanusGraph Dialect = "janusgraph"
+	// TinkerGraph accepts every step and uses long IDs.
+	TinkerGraph Dialect = "tinkergraph"
+	// Neptune uses string IDs and does not expose the graph
+	// object, lambdas or GraphComputer based steps.
+	Neptune Dialect = "neptune"
+	// CosmosDB uses string IDs and supports a smaller set
+	// of steps without lambdas.
+	CosmosDB Dialect = "cosmosdb"
+)
+
+// unsupported lists the steps each provider rejects.
+var unsupported = map[Dialect]map[string]bool{
+	Neptune: stepSet(
+		"io", "program", "pageRank", "peerPressure", "connectedComponent",
+		"shortestPath", "withComputer", "call",
+	),
+	CosmosDB: stepSet(
+		"io", "program", "pageRank", "peerPressure", "connectedComponent",
+		"shortestPath", "withComputer", "call", "math", "match", "mergeV",
+		"mergeE", "sack", "withSack", "subgraph", "timeLimit", "withStrategies",
+		"withoutStrategies", "element", "index", "fail",
+	),
+}
+
+func stepSet(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[n] = true
+	}
+	return set
+}
+
+// Supports returns whether the provider accepts the given step.
+func (d Dialect) Supports(step string) bool {
+	return !unsupported[d][step]
+}
+
+// SupportsManagement returns whether the provider exposes the
+// JanusGraph management API used by the graph package.
+func (d Dialect) SupportsManagement() bool {
+	return d == Generic || d == JanusGraph
+}
+
+// SupportsLambdas returns whether the provider runs lambdas
+// such as Groovy closures given to map() or filter().
+func (d Dialect) SupportsLambdas() bool {
+	return d != Neptune && d != CosmosDB
+}
+
+// StringIDs returns whether elements on the provider are
+// identified by strings rather than numbers.
+func (d Dialect) StringIDs() bool {
+	return d == Neptune || d == CosmosDB
+}
+
+// LongIDs returns whether numeric IDs have to be written
+// as long literals such as 1L to match elements.
+func (d Dialect) LongIDs() bool {
+	return d == TinkerGraph
+}
+
+// Name returns the human readable name of the provider.
+func (d Dialect) Name() string {
+	switch d {
+	case JanusGraph:
+		return "JanusGraph"
+	case TinkerGraph:
+		return "TinkerGraph"
+	case Neptune:
+		return "Neptune"
+	case CosmosDB:
+		return "Cosmos DB"
+	default:
+		return "Generic"
+	}
+}
+
+func (d Dialect) String() string {
+	return string(d)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package dt contains the object to choose the unit of time used by the dateAdd() step.
+
+See: https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/DT.html
+
+A note about DT:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package dt
+
+// https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/DT.html
+
+// DT is a unit of time that dateAdd() adds to a date.
+type DT string
+
+const (
+	// Second adds seconds to the date.
+	Second DT = "DT.second"
+	// Minute adds minutes to the date.
+	Minute DT = "DT.minute"
+	// Hour adds hours to the date.
+	Hour DT = "DT.hour"
+	// Day adds days to the date.
+	Day DT = "DT.day"
+)
+
+func (d DT) String() string {
+	return string(d)
+}
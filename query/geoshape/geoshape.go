@@ -0,0 +1,210 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package geoshape contains the object to store and query locations in JanusGraph.
+
+See: https://docs.janusgraph.org/interactions/search-predicates/#geo-predicate
+
+Geoshapes are given as property values or to the geo predicates such as
+predicate.GeoWithin(). They're also decoded from the janusgraph:Geoshape
+values the server returns.
+
+A note about Geoshape:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package geoshape
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// GraphSONType is the GraphSON type JanusGraph gives geoshape values.
+const GraphSONType = "janusgraph:Geoshape"
+
+// Type is the kind of shape a Geoshape is.
+type Type string
+
+const (
+	// Point is a single location.
+	Point Type = "Point"
+	// Circle is a location and a radius around it in kilometers.
+	Circle Type = "Circle"
+	// Box is the area between a south west and a north east corner.
+	Box Type = "Box"
+	// Line is a path through two or more locations.
+	Line Type = "LineString"
+	// Polygon is the area inside a ring of locations.
+	Polygon Type = "Polygon"
+)
+
+// Coordinate is a location in degrees.
+type Coordinate struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Geoshape is a shape JanusGraph can store and search.
+type Geoshape struct {
+	Type        Type
+	Coordinates []Coordinate
+	// Radius is the radius of a Circle in kilometers.
+	Radius float64
+
+	wkt string
+}
+
+// NewPoint returns a point at the latitude and longitude.
+func NewPoint(latitude, longitude float64) Geoshape {
+	return Geoshape{
+		Type:        Point,
+		Coordinates: []Coordinate{{latitude, longitude}},
+	}
+}
+
+// NewCircle returns a circle around the latitude
+// and longitude with the radius in kilometers.
+func NewCircle(latitude, longitude, radius float64) Geoshape {
+	return Geoshape{
+		Type:        Circle,
+		Coordinates: []Coordinate{{latitude, longitude}},
+		Radius:      radius,
+	}
+}
+
+// NewBox returns a box between the south west
+// and north east corners.
+func NewBox(southWestLatitude, southWestLongitude, northEastLatitude, northEastLongitude float64) Geoshape {
+	return Geoshape{
+		Type: Box,
+		Coordinates: []Coordinate{
+			{southWestLatitude, southWestLongitude},
+			{northEastLatitude, northEastLongitude},
+		},
+	}
+}
+
+// NewLine returns a line through the coordinates.
+func NewLine(coordinates ...Coordinate) Geoshape {
+	return Geoshape{
+		Type:        Line,
+		Coordinates: coordinates,
+	}
+}
+
+// NewPolygon returns a polygon with the coordinates as its
+// ring. The ring doesn't need to end on its first coordinate.
+func NewPolygon(coordinates ...Coordinate) Geoshape {
+	return Geoshape{
+		Type:        Polygon,
+		Coordinates: coordinates,
+	}
+}
+
+// FromWKT returns a shape from its well-known text such as
+// "POLYGON ((-120 30, -110 30, -110 40, -120 30))". The text is
+// read by the server so it isn't checked here.
+func FromWKT(wkt string) Geoshape {
+	return Geoshape{wkt: wkt}
+}
+
+// String renders the shape as the JanusGraph Geoshape call
+// that creates it. Lines and polygons are given as well-known
+// text since the server can't build them from Gremlin lists.
+func (g Geoshape) String() string {
+	if g.wkt != "" {
+		return "Geoshape.fromWkt(" + literal.Quote(g.wkt) + ")"
+	}
+
+	switch g.Type {
+	case Point:
+		return "Geoshape.point(" + g.latLon(0) + ")"
+	case Circle:
+		return "Geoshape.circle(" + g.latLon(0) + "," + formatFloat(g.Radius) + ")"
+	case Box:
+		return "Geoshape.box(" + g.latLon(0) + "," + g.latLon(1) + ")"
+	case Line, Polygon:
+		return "Geoshape.fromWkt(" + literal.Quote(g.WKT()) + ")"
+	}
+
+	return ""
+}
+
+// WKT returns the well-known text of the shape.
+func (g Geoshape) WKT() string {
+	if g.wkt != "" {
+		return g.wkt
+	}
+
+	coords := g.Coordinates
+
+	switch g.Type {
+	case Point:
+		return "POINT (" + lonLat(coords...) + ")"
+	case Line:
+		return "LINESTRING (" + lonLat(coords...) + ")"
+	case Polygon:
+		if len(coords) > 0 && coords[0] != coords[len(coords)-1] {
+			coords = append(coords[:len(coords):len(coords)], coords[0])
+		}
+		return "POLYGON ((" + lonLat(coords...) + "))"
+	case Box:
+		if len(coords) < 2 {
+			return ""
+		}
+		sw, ne := coords[0], coords[1]
+		return NewPolygon(sw, Coordinate{sw.Latitude, ne.Longitude}, ne,
+			Coordinate{ne.Latitude, sw.Longitude}).WKT()
+	}
+
+	return ""
+}
+
+func (g Geoshape) latLon(i int) string {
+	if i >= len(g.Coordinates) {
+		return "0,0"
+	}
+
+	c := g.Coordinates[i]
+	return formatFloat(c.Latitude) + "," + formatFloat(c.Longitude)
+}
+
+// lonLat writes coordinates in the longitude
+// first order that well-known text uses.
+func lonLat(coords ...Coordinate) string {
+	buf := bytes.NewBufferString("")
+
+	for i, c := range coords {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(formatFloat(c.Longitude) + " " + formatFloat(c.Latitude))
+	}
+
+	return buf.String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
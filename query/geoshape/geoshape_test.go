@@ -0,0 +1,84 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package geoshape
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGeoshapeString(t *testing.T) {
+	Convey("Given the geoshape constructors", t, func() {
+		Convey("When a point is made", func() {
+			Convey("Then it should render as Geoshape.point", func() {
+				So(NewPoint(44.9, -93.2).String(), ShouldEqual, "Geoshape.point(44.9,-93.2)")
+			})
+		})
+
+		Convey("When a circle is made", func() {
+			Convey("Then it should render as Geoshape.circle", func() {
+				So(NewCircle(44.9, -93.2, 10).String(), ShouldEqual, "Geoshape.circle(44.9,-93.2,10)")
+			})
+		})
+
+		Convey("When a box is made", func() {
+			Convey("Then it should render as Geoshape.box", func() {
+				So(NewBox(40, -100, 45, -90).String(), ShouldEqual, "Geoshape.box(40,-100,45,-90)")
+			})
+		})
+
+		Convey("When a line is made", func() {
+			l := NewLine(Coordinate{40, -100}, Coordinate{45, -90})
+			Convey("Then it should render as well-known text in longitude first order", func() {
+				So(l.String(), ShouldEqual, "Geoshape.fromWkt(\"LINESTRING (-100 40, -90 45)\")")
+			})
+		})
+
+		Convey("When a polygon is made with an open ring", func() {
+			p := NewPolygon(Coordinate{30, -120}, Coordinate{30, -110}, Coordinate{40, -110})
+			Convey("Then the ring should be closed", func() {
+				So(p.String(), ShouldEqual, "Geoshape.fromWkt(\"POLYGON ((-120 30, -110 30, -110 40, -120 30))\")")
+			})
+		})
+
+		Convey("When a shape is made from well-known text", func() {
+			s := FromWKT("POINT (-93.2 44.9)")
+			Convey("Then it should render as Geoshape.fromWkt", func() {
+				So(s.String(), ShouldEqual, "Geoshape.fromWkt(\"POINT (-93.2 44.9)\")")
+				So(s.WKT(), ShouldEqual, "POINT (-93.2 44.9)")
+			})
+		})
+
+		Convey("When a shape is made from text holding quotes and dollar signs", func() {
+			s := FromWKT(`POINT "${x}"`)
+			Convey("Then they should be escaped", func() {
+				So(s.String(), ShouldEqual, `Geoshape.fromWkt("POINT \"\${x}\"")`)
+			})
+		})
+
+		Convey("When a box is turned into well-known text", func() {
+			Convey("Then it should be a polygon of its corners", func() {
+				So(NewBox(40, -100, 45, -90).WKT(), ShouldEqual, "POLYGON ((-100 40, -90 40, -90 45, -100 45, -100 40))")
+			})
+		})
+	})
+}
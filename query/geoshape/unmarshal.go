@@ -0,0 +1,195 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package geoshape
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrUnsupportedShape is returned when decoding a shape this package doesn't know.
+var ErrUnsupportedShape = errors.New("unsupported geoshape")
+
+// FromGeoJSON returns a shape from its GeoJSON geometry or feature.
+// A circle is a point feature with a "radius" property in kilometers.
+func FromGeoJSON(data []byte) (Geoshape, error) {
+	var g Geoshape
+	err := json.Unmarshal(data, &g)
+	return g, err
+}
+
+// UnmarshalJSON decodes a janusgraph:Geoshape GraphSON
+// value or a plain GeoJSON geometry or feature.
+func (g *Geoshape) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	obj, ok := untype(raw).(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%w: expected an object", ErrUnsupportedShape)
+	}
+
+	// features hold the geometry and the radius of circles separately.
+	if geometry, ok := obj["geometry"].(map[string]interface{}); ok {
+		if props, ok := obj["properties"].(map[string]interface{}); ok {
+			if r, ok := props["radius"]; ok {
+				geometry["radius"] = r
+			}
+		}
+		obj = geometry
+	}
+
+	shapeType, _ := obj["type"].(string)
+	coords := obj["coordinates"]
+
+	var (
+		res Geoshape
+		err error
+	)
+
+	switch Type(shapeType) {
+	case Point, Circle:
+		var c Coordinate
+		if c, err = toCoordinate(coords); err != nil {
+			return err
+		}
+		res = NewPoint(c.Latitude, c.Longitude)
+
+		if r, ok := obj["radius"].(float64); ok {
+			res.Type = Circle
+			res.Radius = r
+		} else if Type(shapeType) == Circle {
+			return fmt.Errorf("%w: circle without a radius", ErrUnsupportedShape)
+		}
+	case Line:
+		res.Type = Line
+		res.Coordinates, err = toCoordinates(coords)
+	case Polygon:
+		rings, ok := coords.([]interface{})
+		if !ok || len(rings) == 0 {
+			return fmt.Errorf("%w: polygon without a ring", ErrUnsupportedShape)
+		}
+		res.Type = Polygon
+		res.Coordinates, err = toCoordinates(rings[0])
+		// the ring is closed again when the polygon is rendered.
+		if n := len(res.Coordinates); err == nil && n > 1 && res.Coordinates[0] == res.Coordinates[n-1] {
+			res.Coordinates = res.Coordinates[:n-1]
+		}
+	case Box:
+		var all []Coordinate
+		if rings, ok := coords.([]interface{}); ok && len(rings) == 1 {
+			coords = rings[0]
+		}
+		if all, err = toCoordinates(coords); err != nil {
+			return err
+		}
+		res = boxAround(all)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedShape, shapeType)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*g = res
+	return nil
+}
+
+// untype removes the GraphSON type wrappers such as
+// {"@type":"g:Double","@value":1.5} from a decoded value.
+func untype(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if val, ok := t["@value"]; ok {
+			if _, typed := t["@type"]; typed {
+				return untype(val)
+			}
+		}
+		for k, val := range t {
+			t[k] = untype(val)
+		}
+		return t
+	case []interface{}:
+		for i, val := range t {
+			t[i] = untype(val)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+// toCoordinate reads a GeoJSON [longitude, latitude] position.
+func toCoordinate(v interface{}) (Coordinate, error) {
+	pos, ok := v.([]interface{})
+	if !ok || len(pos) < 2 {
+		return Coordinate{}, fmt.Errorf("%w: invalid position %v", ErrUnsupportedShape, v)
+	}
+
+	lon, okLon := pos[0].(float64)
+	lat, okLat := pos[1].(float64)
+	if !okLon || !okLat {
+		return Coordinate{}, fmt.Errorf("%w: invalid position %v", ErrUnsupportedShape, v)
+	}
+
+	return Coordinate{Latitude: lat, Longitude: lon}, nil
+}
+
+func toCoordinates(v interface{}) ([]Coordinate, error) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid positions %v", ErrUnsupportedShape, v)
+	}
+
+	coords := make([]Coordinate, 0, len(list))
+	for _, pos := range list {
+		c, err := toCoordinate(pos)
+		if err != nil {
+			return nil, err
+		}
+		coords = append(coords, c)
+	}
+
+	return coords, nil
+}
+
+// boxAround returns the box holding every coordinate, since
+// a box may be given by its two corners or by its whole ring.
+func boxAround(coords []Coordinate) Geoshape {
+	if len(coords) == 0 {
+		return Geoshape{Type: Box}
+	}
+
+	sw, ne := coords[0], coords[0]
+	for _, c := range coords[1:] {
+		sw.Latitude = math.Min(sw.Latitude, c.Latitude)
+		sw.Longitude = math.Min(sw.Longitude, c.Longitude)
+		ne.Latitude = math.Max(ne.Latitude, c.Latitude)
+		ne.Longitude = math.Max(ne.Longitude, c.Longitude)
+	}
+
+	return NewBox(sw.Latitude, sw.Longitude, ne.Latitude, ne.Longitude)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package geoshape
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFromGeoJSON(t *testing.T) {
+	Convey("Given GeoJSON shapes", t, func() {
+		Convey("When a point geometry is decoded", func() {
+			g, err := FromGeoJSON([]byte(`{"type":"Point","coordinates":[-93.2,44.9]}`))
+			Convey("Then a point should be returned", func() {
+				So(err, ShouldBeNil)
+				So(g, ShouldResemble, NewPoint(44.9, -93.2))
+			})
+		})
+
+		Convey("When a point feature with a radius is decoded", func() {
+			g, err := FromGeoJSON([]byte(`{"type":"Feature","geometry":{"type":"Point","coordinates":[-93.2,44.9]},"properties":{"radius":10}}`))
+			Convey("Then a circle should be returned", func() {
+				So(err, ShouldBeNil)
+				So(g, ShouldResemble, NewCircle(44.9, -93.2, 10))
+			})
+		})
+
+		Convey("When a line is decoded", func() {
+			g, err := FromGeoJSON([]byte(`{"type":"LineString","coordinates":[[-100,40],[-90,45]]}`))
+			Convey("Then a line should be returned", func() {
+				So(err, ShouldBeNil)
+				So(g, ShouldResemble, NewLine(Coordinate{40, -100}, Coordinate{45, -90}))
+			})
+		})
+
+		Convey("When a closed polygon is decoded", func() {
+			g, err := FromGeoJSON([]byte(`{"type":"Polygon","coordinates":[[[-120,30],[-110,30],[-110,40],[-120,30]]]}`))
+			Convey("Then the closing coordinate should be dropped", func() {
+				So(err, ShouldBeNil)
+				So(g, ShouldResemble, NewPolygon(Coordinate{30, -120}, Coordinate{30, -110}, Coordinate{40, -110}))
+			})
+		})
+
+		Convey("When an unknown shape is decoded", func() {
+			_, err := FromGeoJSON([]byte(`{"type":"Hexagon","coordinates":[]}`))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUnmarshalGraphSON(t *testing.T) {
+	Convey("Given janusgraph:Geoshape GraphSON values", t, func() {
+		Convey("When a typed circle is decoded", func() {
+			var g Geoshape
+			err := g.UnmarshalJSON([]byte(`{
+				"@type": "janusgraph:Geoshape",
+				"@value": {
+					"type": "Circle",
+					"coordinates": [{"@type":"g:Double","@value":-93.2},{"@type":"g:Double","@value":44.9}],
+					"radius": {"@type":"g:Double","@value":10.5}
+				}
+			}`))
+			Convey("Then a circle should be returned", func() {
+				So(err, ShouldBeNil)
+				So(g, ShouldResemble, NewCircle(44.9, -93.2, 10.5))
+			})
+		})
+
+		Convey("When a box is decoded from its ring", func() {
+			var g Geoshape
+			err := g.UnmarshalJSON([]byte(`{
+				"@type": "janusgraph:Geoshape",
+				"@value": {"type": "Box", "coordinates": [[[-100,40],[-90,40],[-90,45],[-100,45],[-100,40]]]}
+			}`))
+			Convey("Then the box should span the ring", func() {
+				So(err, ShouldBeNil)
+				So(g, ShouldResemble, NewBox(40, -100, 45, -90))
+			})
+		})
+
+		Convey("When a circle without a radius is decoded", func() {
+			var g Geoshape
+			err := g.UnmarshalJSON([]byte(`{"type":"Circle","coordinates":[-93.2,44.9]}`))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// AwaitGraphIndexStatus waits until every instance reports the
+// status for the graph index with the given name. JanusGraph waits
+// for a minute unless another timeout is given.
+// Signatures:
+// AwaitGraphIndexStatus(string, index.Status)
+// AwaitGraphIndexStatus(string, index.Status, time.Duration)
+func AwaitGraphIndexStatus(name string, status index.Status, timeout ...time.Duration) String {
+	graph := String("ManagementSystem.awaitGraphIndexStatus(graph," + literal.Quote(name) + ")")
+
+	return graph.awaitStatus(status, timeout...)
+}
+
+// AwaitEdgeIndexStatus waits until every instance reports the
+// status for the vertex-centric index with the given name on
+// the edge label.
+// Signatures:
+// AwaitEdgeIndexStatus(string, string, index.Status)
+// AwaitEdgeIndexStatus(string, string, index.Status, time.Duration)
+func AwaitEdgeIndexStatus(label, name string, status index.Status, timeout ...time.Duration) String {
+	graph := String("ManagementSystem.awaitRelationIndexStatus(graph," + literal.Quote(name) + "," + literal.Quote(label) + ")")
+
+	return graph.awaitStatus(status, timeout...)
+}
+
+func (graph String) awaitStatus(status index.Status, timeout ...time.Duration) String {
+	graph = graph.append(".status(" + status.String() + ")")
+	if len(timeout) > 0 {
+		ms := strconv.FormatInt(int64(timeout[0]/time.Millisecond), 10)
+		graph = graph.append(".timeout(" + ms + ",java.time.temporal.ChronoUnit.MILLIS)")
+	}
+	graph = graph.append(".call()")
+
+	return graph
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/literal"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// BuildEdgeIndex builds a vertex-centric index for the edges
+// with the given label, sorted by the property keys in the order.
+// Signatures:
+// BuildEdgeIndex(string, string, direction.Direction, order.Order, string...)
+func (graph String) BuildEdgeIndex(label, name string, dir direction.Direction, sort order.Order, keys ...string) String {
+	graph = graph.append(".buildEdgeIndex(" + edgeLabel(label) + "," + literal.Quote(name) + "," +
+		dir.String() + "," + sort.String())
+	for _, k := range keys {
+		graph = graph.append("," + propertyKey(k))
+	}
+	graph = graph.append(")")
+
+	return graph
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+func TestBuildEdgeIndex(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		g := NewManagement()
+		Convey("When 'BuildEdgeIndex' is called with sort keys", func() {
+			result := g.BuildEdgeIndex("battled", "battlesByTime", direction.Both, order.Desc, "time", "place")
+			Convey("Then the label and keys should be looked up", func() {
+				So(result.String(), ShouldEqual, "mgmt.buildEdgeIndex(mgmt.getEdgeLabel(\"battled\"),\"battlesByTime\",BOTH,Order.desc,mgmt.getPropertyKey(\"time\"),mgmt.getPropertyKey(\"place\"))")
+			})
+		})
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// BuildIndex starts building a graph index with
+// the given name for the vertices, edges or vertex
+// properties. It is finished with BuildCompositeIndex
+// or BuildMixedIndex.
+// Signatures:
+// BuildIndex(string, index.Element)
+func (graph String) BuildIndex(name string, element index.Element) String {
+	graph = graph.append(".buildIndex(" + literal.Quote(name) + "," + element.String() + ")")
+	return graph
+}
+
+// AddKey adds an existing property key to the index.
+// The mapping is only used by mixed indexes.
+// Signatures:
+// AddKey(string)
+// AddKey(string, index.Mapping)
+func (graph String) AddKey(key string, mapping ...index.Mapping) String {
+	graph = graph.append(".addKey(" + propertyKey(key))
+	if len(mapping) > 0 {
+		graph = graph.append("," + mapping[0].String() + ".asParameter()")
+	}
+	graph = graph.append(")")
+
+	return graph
+}
+
+// Unique makes a composite index allow only
+// one element for each combination of keys.
+func (graph String) Unique() String {
+	graph = graph.append(".unique()")
+	return graph
+}
+
+// IndexOnly limits the index to the
+// vertices with the given vertex label.
+func (graph String) IndexOnly(label string) String {
+	graph = graph.append(".indexOnly(" + vertexLabel(label) + ")")
+	return graph
+}
+
+// IndexOnlyEdges limits the index to the
+// edges with the given edge label.
+func (graph String) IndexOnlyEdges(label string) String {
+	graph = graph.append(".indexOnly(" + edgeLabel(label) + ")")
+	return graph
+}
+
+// BuildCompositeIndex finishes the index as a composite
+// index which is stored by the storage backend.
+func (graph String) BuildCompositeIndex() String {
+	graph = graph.append(".buildCompositeIndex()")
+	return graph
+}
+
+// BuildMixedIndex finishes the index as a mixed index which
+// is stored by the indexing backend with the given name,
+// such as "search".
+func (graph String) BuildMixedIndex(backend string) String {
+	graph = graph.append(".buildMixedIndex(" + literal.Quote(backend) + ")")
+	return graph
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/northwesternmutual/grammes/query/consistency"
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// SetTTL makes the elements of the vertex label or edge label,
+// or the values of the property key, expire after the duration.
+// Signatures:
+// SetTTL(SchemaType, time.Duration)
+func (graph String) SetTTL(t SchemaType, ttl time.Duration) String {
+	ms := strconv.FormatInt(int64(ttl/time.Millisecond), 10)
+	graph = graph.append(".setTTL(" + t.String() + ",java.time.Duration.ofMillis(" + ms + "))")
+	return graph
+}
+
+// SetConsistency sets how the storage backend keeps the
+// property key, edge label or graph index consistent.
+// Signatures:
+// SetConsistency(SchemaType, consistency.Modifier)
+func (graph String) SetConsistency(t SchemaType, modifier consistency.Modifier) String {
+	graph = graph.append(".setConsistency(" + t.String() + "," + modifier.String() + ")")
+	return graph
+}
+
+// AddProperties allows the existing property keys
+// on the vertex label or edge label.
+// Signatures:
+// AddProperties(SchemaType, string...)
+func (graph String) AddProperties(label SchemaType, keys ...string) String {
+	graph = graph.append(".addProperties(" + label.String())
+	for _, k := range keys {
+		graph = graph.append("," + propertyKey(k))
+	}
+	graph = graph.append(")")
+
+	return graph
+}
+
+// ChangeName renames the existing schema type.
+// Signatures:
+// ChangeName(SchemaType, string)
+func (graph String) ChangeName(t SchemaType, name string) String {
+	graph = graph.append(".changeName(" + t.String() + "," + literal.Quote(name) + ")")
+	return graph
+}
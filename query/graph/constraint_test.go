@@ -0,0 +1,85 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/consistency"
+)
+
+func TestSetTTL(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		g := NewManagement()
+		Convey("When 'SetTTL' is called with a vertex label", func() {
+			result := g.SetTTL(VertexLabel("tweet"), 7*24*time.Hour)
+			Convey("Then the duration should be rendered in milliseconds", func() {
+				So(result.String(), ShouldEqual, "mgmt.setTTL(mgmt.getVertexLabel(\"tweet\"),java.time.Duration.ofMillis(604800000))")
+			})
+		})
+	})
+}
+
+func TestSetConsistency(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		g := NewManagement()
+		Convey("When 'SetConsistency' is called with a property key", func() {
+			result := g.SetConsistency(PropertyKey("name"), consistency.Lock)
+			Convey("Then result should equal 'mgmt.setConsistency(mgmt.getPropertyKey('name'),ConsistencyModifier.LOCK)'", func() {
+				So(result.String(), ShouldEqual, "mgmt.setConsistency(mgmt.getPropertyKey(\"name\"),ConsistencyModifier.LOCK)")
+			})
+		})
+
+		Convey("When 'SetConsistency' is called with a graph index", func() {
+			result := g.SetConsistency(GraphIndex("byName"), consistency.Lock)
+			Convey("Then the index should be looked up", func() {
+				So(result.String(), ShouldEqual, "mgmt.setConsistency(mgmt.getGraphIndex(\"byName\"),ConsistencyModifier.LOCK)")
+			})
+		})
+	})
+}
+
+func TestAddProperties(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		g := NewManagement()
+		Convey("When 'AddProperties' is called with a vertex label and keys", func() {
+			result := g.AddProperties(VertexLabel("person"), "name", "age")
+			Convey("Then the label and keys should be looked up", func() {
+				So(result.String(), ShouldEqual, "mgmt.addProperties(mgmt.getVertexLabel(\"person\"),mgmt.getPropertyKey(\"name\"),mgmt.getPropertyKey(\"age\"))")
+			})
+		})
+	})
+}
+
+func TestChangeName(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		g := NewManagement()
+		Convey("When 'ChangeName' is called with an edge label", func() {
+			result := g.ChangeName(EdgeLabel("knows"), "friend")
+			Convey("Then result should equal 'mgmt.changeName(mgmt.getEdgeLabel('knows'),'friend')'", func() {
+				So(result.String(), ShouldEqual, "mgmt.changeName(mgmt.getEdgeLabel(\"knows\"),\"friend\")")
+			})
+		})
+	})
+}
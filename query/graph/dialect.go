@@ -0,0 +1,45 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/dialect"
+)
+
+// Render checks that the given provider exposes the graph object
+// used by these commands. Only JanusGraph, and the generic dialect,
+// support the management API so every other provider is rejected
+// before anything is sent to the server.
+func (graph String) Render(d dialect.Dialect) (String, error) {
+	if !d.SupportsManagement() {
+		step := strings.TrimPrefix(strings.TrimPrefix(graph.String(), mgmt+" = "), "graph.")
+		if i := strings.Index(step, "("); i >= 0 {
+			step = step[:i]
+		}
+		return graph, gremerror.NewValidationError(step, 0,
+			"the graph object is not supported by "+d.Name())
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/dialect"
+)
+
+func TestRender(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		g := NewGraph().OpenManagement().MakeVertexLabel("person").Make()
+		Convey("When 'Render' is called for JanusGraph", func() {
+			result, err := g.Render(dialect.JanusGraph)
+			Convey("Then the result should be unchanged", func() {
+				So(err, ShouldBeNil)
+				So(result.String(), ShouldEqual, g.String())
+			})
+		})
+
+		Convey("When 'Render' is called for the generic dialect", func() {
+			_, err := g.Render(dialect.Generic)
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When 'Render' is called for every other dialect", func() {
+			Convey("Then an error should be returned", func() {
+				for _, d := range []dialect.Dialect{dialect.TinkerGraph, dialect.Neptune, dialect.CosmosDB} {
+					_, err := g.Render(d)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, "openManagement")
+				}
+			})
+		})
+
+		Convey("When 'Render' is called for a management transaction", func() {
+			_, err := Management(NewManagement().MakeVertexLabel("person").Make()).Render(dialect.Neptune)
+			Convey("Then the error should name the step", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "openManagement")
+				So(err.Error(), ShouldNotContainSubstring, "mgmt =")
+			})
+		})
+	})
+}
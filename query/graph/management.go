@@ -0,0 +1,70 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"strings"
+
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// mgmt is the variable that holds the management
+// system opened by Management.
+const mgmt = "mgmt"
+
+// NewManagement will return a new String that starts from
+// the management system opened by Management. It is used for
+// the statements that need to look up existing schema types.
+func NewManagement() (graph String) {
+	graph = mgmt
+	return
+}
+
+// Management wraps the statements in a single management
+// transaction that is opened before and committed after them.
+// Signatures:
+// Management(String...)
+func Management(statements ...String) String {
+	var b strings.Builder
+
+	b.WriteString(mgmt + " = graph.openManagement();")
+	for _, s := range statements {
+		b.WriteString(s.String() + ";")
+	}
+	b.WriteString(mgmt + ".commit()")
+
+	return String(b.String())
+}
+
+// propertyKey looks up an existing property key.
+func propertyKey(name string) string {
+	return mgmt + ".getPropertyKey(" + literal.Quote(name) + ")"
+}
+
+// edgeLabel looks up an existing edge label.
+func edgeLabel(name string) string {
+	return mgmt + ".getEdgeLabel(" + literal.Quote(name) + ")"
+}
+
+// vertexLabel looks up an existing vertex label.
+func vertexLabel(name string) string {
+	return mgmt + ".getVertexLabel(" + literal.Quote(name) + ")"
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import "github.com/northwesternmutual/grammes/query/literal"
+
+// SchemaType refers to an existing schema type
+// by name, from the management system opened by
+// Management, so it can be configured.
+type SchemaType string
+
+// PropertyKey refers to an existing property key.
+func PropertyKey(name string) SchemaType {
+	return SchemaType(propertyKey(name))
+}
+
+// EdgeLabel refers to an existing edge label.
+func EdgeLabel(name string) SchemaType {
+	return SchemaType(edgeLabel(name))
+}
+
+// VertexLabel refers to an existing vertex label.
+func VertexLabel(name string) SchemaType {
+	return SchemaType(vertexLabel(name))
+}
+
+// GraphIndex refers to an existing graph index.
+func GraphIndex(name string) SchemaType {
+	return SchemaType(mgmt + ".getGraphIndex(" + literal.Quote(name) + ")")
+}
+
+func (s SchemaType) String() string {
+	return string(s)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"github.com/northwesternmutual/grammes/query/index"
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// UpdateIndex applies the action to the graph index with the
+// given name. It returns a job that can be waited for with Get.
+// Signatures:
+// UpdateIndex(string, index.Action)
+func (graph String) UpdateIndex(name string, action index.Action) String {
+	graph = graph.append(".updateIndex(" + mgmt + ".getGraphIndex(" + literal.Quote(name) + ")," + action.String() + ")")
+	return graph
+}
+
+// UpdateEdgeIndex applies the action to the vertex-centric
+// index with the given name on the edge label.
+// Signatures:
+// UpdateEdgeIndex(string, string, index.Action)
+func (graph String) UpdateEdgeIndex(label, name string, action index.Action) String {
+	graph = graph.append(".updateIndex(" + mgmt + ".getRelationIndex(" + edgeLabel(label) +
+		"," + literal.Quote(name) + ")," + action.String() + ")")
+	return graph
+}
+
+// Get waits for the job started by UpdateIndex to finish.
+func (graph String) Get() String {
+	graph = graph.append(".get()")
+	return graph
+}
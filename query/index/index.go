@@ -0,0 +1,125 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package index contains the objects to build and manage JanusGraph indexes.
+
+See: https://docs.janusgraph.org/schema/index-management/index-performance/
+
+Graph indexes are built for the vertices, edges or vertex properties
+given by an Element. Mixed indexes map each key to the indexing backend
+with a Mapping. Indexes are moved through their lifecycle with an Action
+and reach a Status once every instance has applied it.
+
+A note about the index objects:
+
+These objects implement the Parameter interfaces used by graph traversals.
+*/
+package index
+
+// JanusGraph:
+// https://docs.janusgraph.org/schema/index-management/index-lifecycle/
+
+// Element is the kind of element a graph index is built for.
+type Element string
+
+const (
+	// Vertex indexes vertices.
+	Vertex Element = "Vertex.class"
+	// Edge indexes edges.
+	Edge Element = "Edge.class"
+	// VertexProperty indexes vertex properties.
+	VertexProperty Element = "VertexProperty.class"
+)
+
+func (e Element) String() string {
+	return string(e)
+}
+
+// Mapping is how a mixed index backend indexes a key.
+type Mapping string
+
+const (
+	// Default uses the default mapping for the key's data type.
+	Default Mapping = "Mapping.DEFAULT"
+	// Text tokenizes a string for full text search.
+	Text Mapping = "Mapping.TEXT"
+	// String indexes a string as a whole.
+	String Mapping = "Mapping.STRING"
+	// TextString indexes a string both as text and as a whole.
+	TextString Mapping = "Mapping.TEXTSTRING"
+	// PrefixTree indexes a geoshape with a prefix tree.
+	PrefixTree Mapping = "Mapping.PREFIX_TREE"
+)
+
+func (m Mapping) String() string {
+	return string(m)
+}
+
+// Key is a property key added to an index
+// with an optional mapping for mixed indexes.
+type Key struct {
+	Name    string
+	Mapping Mapping
+}
+
+// Action is a change to an index's lifecycle.
+type Action string
+
+const (
+	// Register registers an installed index with every instance.
+	Register Action = "SchemaAction.REGISTER_INDEX"
+	// Reindex builds the index from the existing data.
+	Reindex Action = "SchemaAction.REINDEX"
+	// Enable makes a registered index available to queries.
+	Enable Action = "SchemaAction.ENABLE_INDEX"
+	// Disable stops queries and writes from using the index.
+	Disable Action = "SchemaAction.DISABLE_INDEX"
+	// Remove deletes a disabled index before JanusGraph 1.0.
+	Remove Action = "SchemaAction.REMOVE_INDEX"
+	// Discard deletes the data of a disabled index since JanusGraph 1.0.
+	Discard Action = "SchemaAction.DISCARD_INDEX"
+	// Drop removes a discarded index from the schema since JanusGraph 1.0.
+	Drop Action = "SchemaAction.DROP_INDEX"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// Status is the state of an index.
+type Status string
+
+const (
+	// Installed indexes are not yet known to every instance.
+	Installed Status = "SchemaStatus.INSTALLED"
+	// Registered indexes are known to every instance.
+	Registered Status = "SchemaStatus.REGISTERED"
+	// Enabled indexes are used by queries.
+	Enabled Status = "SchemaStatus.ENABLED"
+	// Disabled indexes are no longer used.
+	Disabled Status = "SchemaStatus.DISABLED"
+	// Discarded indexes have had their data deleted.
+	Discarded Status = "SchemaStatus.DISCARDED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package literal renders Go values as Groovy literals for the
+scripts sent to the Gremlin server.
+
+Strings are rendered in double quotes, which Groovy treats as
+GStrings that evaluate any ${...} inside them. Quote escapes
+the dollar sign along with the quote and backslash so a value
+is always sent as plain text and never as code.
+*/
+package literal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Quote wraps the string in double quotes and escapes
+// the characters that would end it early or that Groovy
+// would interpolate.
+func Quote(str string) string {
+	return "\"" + Escape(str) + "\""
+}
+
+// Arg renders a step or option argument. Strings are
+// quoted, and anything else, such as traversals, tokens
+// and numbers, is written as it renders itself.
+func Arg(val interface{}) string {
+	switch t := val.(type) {
+	case string:
+		return Quote(t)
+	case fmt.Stringer:
+		return t.String()
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+// Escape escapes the string to be written
+// between double quotes in a script.
+func Escape(str string) string {
+	return replacer.Replace(str)
+}
+
+var replacer = strings.NewReplacer(
+	"\\", "\\\\",
+	"\"", "\\\"",
+	"$", "\\$",
+	"\n", "\\n",
+	"\r", "\\r",
+	"\t", "\\t",
+)
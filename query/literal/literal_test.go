@@ -0,0 +1,78 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package literal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type stringer string
+
+func (s stringer) String() string { return string(s) }
+
+func TestQuote(t *testing.T) {
+	Convey("Given strings with characters that need escaping", t, func() {
+		Convey("When Quote is called", func() {
+			Convey("Then the quotes, backslashes and whitespace should be escaped", func() {
+				So(Quote(`say "hi"`), ShouldEqual, `"say \"hi\""`)
+				So(Quote(`C:\dir`), ShouldEqual, `"C:\\dir"`)
+				So(Quote("a\nb\tc\r"), ShouldEqual, `"a\nb\tc\r"`)
+			})
+			Convey("Then dollar signs should be escaped so they aren't interpolated", func() {
+				So(Quote("a$b"), ShouldEqual, `"a\$b"`)
+				So(Quote("${x}"), ShouldEqual, `"\${x}"`)
+				So(Quote("^foo$"), ShouldEqual, `"^foo\$"`)
+			})
+			Convey("Then an escaped dollar sign should stay escaped", func() {
+				So(Quote(`\${x}`), ShouldEqual, `"\\\${x}"`)
+			})
+		})
+	})
+}
+
+func TestEscape(t *testing.T) {
+	Convey("Given a string with a dollar sign and a quote", t, func() {
+		Convey("When Escape is called", func() {
+			Convey("Then it should be escaped without surrounding quotes", func() {
+				So(Escape(`"$`), ShouldEqual, `\"\$`)
+			})
+		})
+	})
+}
+
+func TestArg(t *testing.T) {
+	Convey("Given arguments of different types", t, func() {
+		Convey("When Arg is called", func() {
+			Convey("Then strings should be quoted and escaped", func() {
+				So(Arg(`a"$b`), ShouldEqual, `"a\"\$b"`)
+			})
+			Convey("Then values that render themselves should be written as they render", func() {
+				So(Arg(stringer("__.out()")), ShouldEqual, "__.out()")
+			})
+			Convey("Then anything else should be written as is", func() {
+				So(Arg(42), ShouldEqual, "42")
+				So(Arg(true), ShouldEqual, "true")
+			})
+		})
+	})
+}
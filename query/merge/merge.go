@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package merge contains the object to control the options of the mergeV() and mergeE() steps.
+
+See: https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/Merge.html
+
+Merge is used with option() to decide what happens when a merge step
+either creates a new element or matches an existing one.
+
+A note about Merge:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package merge
+
+// https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/Merge.html
+
+// Merge is the event of a mergeV() or mergeE()
+// step that an option() is applied to.
+type Merge string
+
+const (
+	// OnCreate is used when the element is created because
+	// nothing matched the search of the merge step.
+	OnCreate Merge = "Merge.onCreate"
+	// OnMatch is used when the search of the
+	// merge step matched an existing element.
+	OnMatch Merge = "Merge.onMatch"
+	// OutV is used to look up the vertex an
+	// edge made by mergeE() comes out of.
+	OutV Merge = "Merge.outV"
+	// InV is used to look up the vertex an
+	// edge made by mergeE() goes into.
+	InV Merge = "Merge.inV"
+)
+
+func (m Merge) String() string {
+	return string(m)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package order contains the object to control how the order() step sorts.
+
+See: https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/Order.html
+
+Order is given to the by() modulator of order() to choose
+the direction the traversers are sorted in.
+
+A note about Order:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package order
+
+// https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/Order.html
+
+// Order is the direction order() sorts its traversers in.
+type Order string
+
+const (
+	// Asc sorts from the smallest value to the largest.
+	Asc Order = "Order.asc"
+	// Desc sorts from the largest value to the smallest.
+	Desc Order = "Order.desc"
+	// Shuffle sorts the traversers randomly.
+	Shuffle Order = "Order.shuffle"
+)
+
+func (o Order) String() string {
+	return string(o)
+}
@@ -20,84 +20,71 @@
 
 package predicate
 
-import (
-	"bytes"
-	"fmt"
-)
-
 // Equal checks if this value is
 // exactly equal to the querying value.
 func Equal(val interface{}) *Predicate {
-	s := fmt.Sprintf("eq(%#v)", val)
-	a := Predicate(s)
-	return &a
+	return newPredicate("eq", val)
 }
 
 // NotEqual check if this value is
 // NOT equal to the query value.
 func NotEqual(val interface{}) *Predicate {
-	s := fmt.Sprintf("neq(%#v)", val)
-	a := Predicate(s)
-	return &a
+	return newPredicate("neq", val)
 }
 
 // LessThan checks if this value is
 // less than the querying value.
 func LessThan(val interface{}) *Predicate {
-	s := fmt.Sprintf("lt(%#v)", val)
-	a := Predicate(s)
-	return &a
+	return newPredicate("lt", val)
 }
 
 // LessThanOrEqual checks if this value is
 // less than or equal to the querying value.
 func LessThanOrEqual(val interface{}) *Predicate {
-	s := fmt.Sprintf("lte(%#v)", val)
-	a := Predicate(s)
-	return &a
+	return newPredicate("lte", val)
 }
 
 // GreaterThan checks if this value is
 // greater than the querying value.
 func GreaterThan(val interface{}) *Predicate {
-	s := fmt.Sprintf("gt(%#v)", val)
-	a := Predicate(s)
-	return &a
+	return newPredicate("gt", val)
 }
 
 // GreaterThanOrEqual checks if this value is
 // greater than or equal to the querying value.
 func GreaterThanOrEqual(val interface{}) *Predicate {
-	s := fmt.Sprintf("gte(%#v)", val)
-	a := Predicate(s)
-	return &a
+	return newPredicate("gte", val)
 }
 
 // Inside checks if this value is
 // within the minimum and maximum querying values.
 func Inside(min, max interface{}) *Predicate {
-	s := fmt.Sprintf("inside(%#v, %#v)", min, max)
-	a := Predicate(s)
-	return &a
+	return newPredicate("inside", min, max)
+}
+
+// Outside checks if this value is below the
+// minimum or above the maximum querying values.
+func Outside(min, max interface{}) *Predicate {
+	return newPredicate("outside", min, max)
+}
+
+// Between checks if this value is greater than or equal
+// to the minimum and less than the maximum querying values.
+func Between(min, max interface{}) *Predicate {
+	return newPredicate("between", min, max)
 }
 
 // Within checks if this value is within the array values.
 func Within(params ...interface{}) *Predicate {
-	buffer := bytes.NewBufferString("within(")
-	sep := ""
-	for _, p := range params {
-		buffer.WriteString(sep)
-		switch t := p.(type) {
-		case string:
-			buffer.WriteString("\"" + t + "\"")
-		default:
-			buffer.WriteString(fmt.Sprintf("%v", t))
-		}
+	return newPredicate("within", params...)
+}
 
-		sep = ","
-	}
+// Without checks if this value is NOT within the array values.
+func Without(params ...interface{}) *Predicate {
+	return newPredicate("without", params...)
+}
 
-	buffer.WriteString(")")
-	a := Predicate(buffer.String())
-	return &a
+// Not checks if the given predicate is NOT true.
+func Not(p *Predicate) *Predicate {
+	return newPredicate("not", p)
 }
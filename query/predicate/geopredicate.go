@@ -20,4 +20,37 @@
 
 package predicate
 
-//!TODO - Add Geoshapes and Geoshape predicates to Grammes.
+import "github.com/northwesternmutual/grammes/query/geoshape"
+
+// Geo search predicates from JanusGraph's Geo which compare a
+// stored geoshape against the given one. They need a mixed index.
+
+// GeoIntersect finds if the stored shape
+// shares any point with the given shape.
+func GeoIntersect(shape geoshape.Geoshape) *Predicate {
+	return newPredicate("geoIntersect", shape)
+}
+
+// GeoInside finds if the stored shape is
+// entirely inside the given shape.
+func GeoInside(shape geoshape.Geoshape) *Predicate {
+	return newPredicate("geoInside", shape)
+}
+
+// GeoWithin finds if the stored shape is within the given
+// shape, including shapes that touch its boundary.
+func GeoWithin(shape geoshape.Geoshape) *Predicate {
+	return newPredicate("geoWithin", shape)
+}
+
+// GeoContains finds if the stored shape
+// contains the given shape.
+func GeoContains(shape geoshape.Geoshape) *Predicate {
+	return newPredicate("geoContains", shape)
+}
+
+// GeoDisjoint finds if the stored shape shares
+// no points with the given shape.
+func GeoDisjoint(shape geoshape.Geoshape) *Predicate {
+	return newPredicate("geoDisjoint", shape)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/geoshape"
+)
+
+func TestGeoPredicate(t *testing.T) {
+	Convey("Given a geoshape", t, func() {
+		shape := geoshape.NewCircle(44.9, -93.2, 10)
+		Convey("When each geo predicate is made with it", func() {
+			Convey("Then the shape should be rendered inside the predicate", func() {
+				So(GeoIntersect(shape).String(), ShouldEqual, "geoIntersect(Geoshape.circle(44.9,-93.2,10))")
+				So(GeoInside(shape).String(), ShouldEqual, "geoInside(Geoshape.circle(44.9,-93.2,10))")
+				So(GeoWithin(shape).String(), ShouldEqual, "geoWithin(Geoshape.circle(44.9,-93.2,10))")
+				So(GeoContains(shape).String(), ShouldEqual, "geoContains(Geoshape.circle(44.9,-93.2,10))")
+				So(GeoDisjoint(shape).String(), ShouldEqual, "geoDisjoint(Geoshape.circle(44.9,-93.2,10))")
+			})
+		})
+	})
+}
@@ -24,12 +24,22 @@ Package predicate contains the object to control the searching predicates of a g
 Predicates are used for when you're trying to narrow the search of a vertex or vertices
 on the graph without having to perform multiple searches.
 
+Predicates can be chained with And() and Or(), negated with Not()
+and given bindings in place of values, such as traversal.Custom("x"),
+since any value with a String() method is written as is.
+
 A note about Predicate:
 
 This object implements the Parameter interfaces used by graph traversals.
 */
 package predicate
 
+import (
+	"bytes"
+
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
 // Predicate is used when you're trying to find
 // values like IDs or property values that meet
 // within the criteria.
@@ -38,3 +48,42 @@ type Predicate string
 func (p *Predicate) String() string {
 	return string(*p)
 }
+
+// And combines the predicate with another
+// so that both of them must be true.
+func (p *Predicate) And(other *Predicate) *Predicate {
+	a := Predicate(p.String() + ".and(" + other.String() + ")")
+	return &a
+}
+
+// Or combines the predicate with another
+// so that either of them must be true.
+func (p *Predicate) Or(other *Predicate) *Predicate {
+	a := Predicate(p.String() + ".or(" + other.String() + ")")
+	return &a
+}
+
+// Negate returns the opposite of the predicate.
+func (p *Predicate) Negate() *Predicate {
+	a := Predicate(p.String() + ".negate()")
+	return &a
+}
+
+// newPredicate renders a predicate with the given values, which
+// are written with literal.Arg and separated by commas alone, so
+// Inside(1, 2) is written as inside(1,2) like the other predicates
+// and like the parser renders it.
+func newPredicate(name string, vals ...interface{}) *Predicate {
+	buffer := bytes.NewBufferString(name + "(")
+
+	for i, v := range vals {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString(literal.Arg(v))
+	}
+
+	buffer.WriteString(")")
+	a := Predicate(buffer.String())
+	return &a
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type binding string
+
+func (b binding) String() string { return string(b) }
+
+func TestPredicate(t *testing.T) {
+	Convey("Given the predicate constructors", t, func() {
+		Convey("When a range predicate is made", func() {
+			Convey("Then the values should be rendered in order", func() {
+				So(Between(1, 5).String(), ShouldEqual, "between(1,5)")
+				So(Outside(1, 5).String(), ShouldEqual, "outside(1,5)")
+				So(Inside(1, 5).String(), ShouldEqual, "inside(1,5)")
+			})
+		})
+
+		Convey("When a collection predicate is made", func() {
+			Convey("Then the strings should be quoted", func() {
+				So(Within("a", 1).String(), ShouldEqual, "within(\"a\",1)")
+				So(Without("a", "b").String(), ShouldEqual, "without(\"a\",\"b\")")
+			})
+		})
+
+		Convey("When a predicate is made with a string holding quotes", func() {
+			Convey("Then the quotes should be escaped", func() {
+				So(Equal("say \"hi\"").String(), ShouldEqual, "eq(\"say \\\"hi\\\"\")")
+			})
+		})
+
+		Convey("When a predicate is made with a string holding a dollar sign", func() {
+			Convey("Then the dollar sign should be escaped so it isn't interpolated", func() {
+				So(Equal("a$b").String(), ShouldEqual, `eq("a\$b")`)
+				So(Within("${x}", "y").String(), ShouldEqual, `within("\${x}","y")`)
+				So(Containing("$").String(), ShouldEqual, `containing("\$")`)
+			})
+		})
+
+		Convey("When a predicate is made with a binding", func() {
+			Convey("Then the binding should not be quoted", func() {
+				So(GreaterThan(binding("minAge")).String(), ShouldEqual, "gt(minAge)")
+			})
+		})
+
+		Convey("When a TextP predicate is made", func() {
+			Convey("Then it should be rendered with its name", func() {
+				So(StartingWith("da").String(), ShouldEqual, "startingWith(\"da\")")
+				So(NotStartingWith("da").String(), ShouldEqual, "notStartingWith(\"da\")")
+				So(EndingWith("en").String(), ShouldEqual, "endingWith(\"en\")")
+				So(NotEndingWith("en").String(), ShouldEqual, "notEndingWith(\"en\")")
+				So(Containing("mi").String(), ShouldEqual, "containing(\"mi\")")
+				So(NotContaining("mi").String(), ShouldEqual, "notContaining(\"mi\")")
+				So(Regex("^d.*").String(), ShouldEqual, "regex(\"^d.*\")")
+				So(NotRegex("^d.*").String(), ShouldEqual, "notRegex(\"^d.*\")")
+			})
+		})
+	})
+}
+
+func TestPredicateCombinators(t *testing.T) {
+	Convey("Given a predicate", t, func() {
+		p := GreaterThan(1)
+		Convey("When it is chained with And and Or", func() {
+			result := p.And(LessThan(5)).Or(Equal(10))
+			Convey("Then the chain should be rendered in order", func() {
+				So(result.String(), ShouldEqual, "gt(1).and(lt(5)).or(eq(10))")
+			})
+			Convey("Then the original predicate should not change", func() {
+				So(p.String(), ShouldEqual, "gt(1)")
+			})
+		})
+
+		Convey("When it is given to Not", func() {
+			Convey("Then it should be wrapped in not()", func() {
+				So(Not(p).String(), ShouldEqual, "not(gt(1))")
+			})
+		})
+
+		Convey("When it is negated", func() {
+			Convey("Then negate() should be appended", func() {
+				So(p.Negate().String(), ShouldEqual, "gt(1).negate()")
+			})
+		})
+	})
+}
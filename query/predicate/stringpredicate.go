@@ -20,29 +20,42 @@
 
 package predicate
 
-// String search predicates which match
-// against the entire string value.
+// String search predicates from JanusGraph's Text which
+// match against the entire string value. These predicates
+// are case sensitive and need a mixed index.
 
 // TextPrefix finds if the string value starts
 // with the given string.
-func TextPrefix(str string) *Predicate {
-	s := "textPrefix(" + str + ")"
-	a := Predicate(s)
-	return &a
+func TextPrefix(str interface{}) *Predicate {
+	return newPredicate("textPrefix", str)
+}
+
+// TextNotPrefix finds if the string value does
+// NOT start with the given string.
+func TextNotPrefix(str interface{}) *Predicate {
+	return newPredicate("textNotPrefix", str)
 }
 
 // TextRegex finds if the string value matches
 // the given regular expression in its entirety.
-func TextRegex(str string) *Predicate {
-	s := "textRegex(" + str + ")"
-	a := Predicate(s)
-	return &a
+func TextRegex(str interface{}) *Predicate {
+	return newPredicate("textRegex", str)
+}
+
+// TextNotRegex finds if the string value does NOT
+// match the given regular expression in its entirety.
+func TextNotRegex(str interface{}) *Predicate {
+	return newPredicate("textNotRegex", str)
 }
 
 // TextFuzzy finds if the string value is
 // similar to the given query string.
-func TextFuzzy(str string) *Predicate {
-	s := "textFuzzy(" + str + ")"
-	a := Predicate(s)
-	return &a
+func TextFuzzy(str interface{}) *Predicate {
+	return newPredicate("textFuzzy", str)
+}
+
+// TextNotFuzzy finds if the string value is NOT
+// similar to the given query string.
+func TextNotFuzzy(str interface{}) *Predicate {
+	return newPredicate("textNotFuzzy", str)
 }
@@ -0,0 +1,100 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStringPredicate(t *testing.T) {
+	Convey("Given a string with quotes and backslashes", t, func() {
+		str := `say "hi" \ bye`
+		Convey("When 'TextPrefix' is called", func() {
+			result := TextPrefix(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textPrefix("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotPrefix' is called", func() {
+			result := TextNotPrefix(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotPrefix("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextRegex' is called", func() {
+			result := TextRegex(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textRegex("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotRegex' is called", func() {
+			result := TextNotRegex(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotRegex("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextFuzzy' is called", func() {
+			result := TextFuzzy(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textFuzzy("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotFuzzy' is called", func() {
+			result := TextNotFuzzy(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotFuzzy("say \"hi\" \\ bye")`)
+			})
+		})
+	})
+
+	Convey("Given a regular expression anchored at both ends", t, func() {
+		regex := "^foo.*bar$"
+		Convey("When 'TextRegex' is called", func() {
+			result := TextRegex(regex)
+			Convey("Then the end anchor should be escaped", func() {
+				So(result.String(), ShouldEqual, `textRegex("^foo.*bar\$")`)
+			})
+		})
+
+		Convey("When 'TextNotRegex' is called", func() {
+			result := TextNotRegex(regex)
+			Convey("Then the end anchor should be escaped", func() {
+				So(result.String(), ShouldEqual, `textNotRegex("^foo.*bar\$")`)
+			})
+		})
+	})
+
+	Convey("Given a binding", t, func() {
+		Convey("When 'TextPrefix' is called", func() {
+			result := TextPrefix(binding("prefix"))
+			Convey("Then the binding should be written as is", func() {
+				So(result.String(), ShouldEqual, "textPrefix(prefix)")
+			})
+		})
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package predicate
+
+// String predicates from TinkerPop's TextP which
+// match against the entire string value and are
+// case sensitive. They work with any graph.
+
+// StartingWith finds if the string value
+// starts with the given string.
+func StartingWith(str interface{}) *Predicate {
+	return newPredicate("startingWith", str)
+}
+
+// NotStartingWith finds if the string value
+// does NOT start with the given string.
+func NotStartingWith(str interface{}) *Predicate {
+	return newPredicate("notStartingWith", str)
+}
+
+// EndingWith finds if the string value
+// ends with the given string.
+func EndingWith(str interface{}) *Predicate {
+	return newPredicate("endingWith", str)
+}
+
+// NotEndingWith finds if the string value
+// does NOT end with the given string.
+func NotEndingWith(str interface{}) *Predicate {
+	return newPredicate("notEndingWith", str)
+}
+
+// Containing finds if the string value
+// contains the given string.
+func Containing(str interface{}) *Predicate {
+	return newPredicate("containing", str)
+}
+
+// NotContaining finds if the string value
+// does NOT contain the given string.
+func NotContaining(str interface{}) *Predicate {
+	return newPredicate("notContaining", str)
+}
+
+// Regex finds if the string value matches
+// the given regular expression.
+func Regex(str interface{}) *Predicate {
+	return newPredicate("regex", str)
+}
+
+// NotRegex finds if the string value does NOT
+// match the given regular expression.
+func NotRegex(str interface{}) *Predicate {
+	return newPredicate("notRegex", str)
+}
@@ -20,37 +20,66 @@
 
 package predicate
 
-// Text search predicates which match against the individual words inside a text
-// string after it has been tokenized. These predicates are not case sensitive.
+// Text search predicates from JanusGraph's Text which match against
+// the individual words inside a text string after it has been tokenized.
+// These predicates are not case sensitive and need a mixed index.
 
 // TextContains finds if at least one word inside
 // the text string matches the query string.
-func TextContains(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+func TextContains(str interface{}) *Predicate {
+	return newPredicate("textContains", str)
+}
+
+// TextNotContains finds if no word inside the
+// text string matches the query string.
+func TextNotContains(str interface{}) *Predicate {
+	return newPredicate("textNotContains", str)
 }
 
 // TextContainsPrefix finds if one word inside
 // the text string begins with the query string.
-func TextContainsPrefix(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+func TextContainsPrefix(str interface{}) *Predicate {
+	return newPredicate("textContainsPrefix", str)
+}
+
+// TextNotContainsPrefix finds if no word inside the
+// text string begins with the query string.
+func TextNotContainsPrefix(str interface{}) *Predicate {
+	return newPredicate("textNotContainsPrefix", str)
 }
 
 // TextContainsRegex finds if one word inside
 // the text string matches the given regular expression.
-func TextContainsRegex(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+func TextContainsRegex(str interface{}) *Predicate {
+	return newPredicate("textContainsRegex", str)
+}
+
+// TextNotContainsRegex finds if no word inside the text
+// string matches the given regular expression.
+func TextNotContainsRegex(str interface{}) *Predicate {
+	return newPredicate("textNotContainsRegex", str)
 }
 
 // TextContainsFuzzy finds if one word inside
 // the text string is similar to the query string.
-func TextContainsFuzzy(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+func TextContainsFuzzy(str interface{}) *Predicate {
+	return newPredicate("textContainsFuzzy", str)
+}
+
+// TextNotContainsFuzzy finds if no word inside the
+// text string is similar to the query string.
+func TextNotContainsFuzzy(str interface{}) *Predicate {
+	return newPredicate("textNotContainsFuzzy", str)
+}
+
+// TextContainsPhrase finds if the words inside the text
+// string contain the words of the query phrase in order.
+func TextContainsPhrase(str interface{}) *Predicate {
+	return newPredicate("textContainsPhrase", str)
+}
+
+// TextNotContainsPhrase finds if the words inside the text string
+// don't contain the words of the query phrase in order.
+func TextNotContainsPhrase(str interface{}) *Predicate {
+	return newPredicate("textNotContainsPhrase", str)
 }
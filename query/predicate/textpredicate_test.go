@@ -0,0 +1,128 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTextPredicate(t *testing.T) {
+	Convey("Given a string with quotes and backslashes", t, func() {
+		str := `say "hi" \ bye`
+		Convey("When 'TextContains' is called", func() {
+			result := TextContains(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textContains("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotContains' is called", func() {
+			result := TextNotContains(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotContains("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextContainsPrefix' is called", func() {
+			result := TextContainsPrefix(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textContainsPrefix("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotContainsPrefix' is called", func() {
+			result := TextNotContainsPrefix(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotContainsPrefix("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextContainsRegex' is called", func() {
+			result := TextContainsRegex(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textContainsRegex("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotContainsRegex' is called", func() {
+			result := TextNotContainsRegex(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotContainsRegex("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextContainsFuzzy' is called", func() {
+			result := TextContainsFuzzy(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textContainsFuzzy("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotContainsFuzzy' is called", func() {
+			result := TextNotContainsFuzzy(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotContainsFuzzy("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextContainsPhrase' is called", func() {
+			result := TextContainsPhrase(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textContainsPhrase("say \"hi\" \\ bye")`)
+			})
+		})
+
+		Convey("When 'TextNotContainsPhrase' is called", func() {
+			result := TextNotContainsPhrase(str)
+			Convey("Then the string should be quoted and escaped", func() {
+				So(result.String(), ShouldEqual, `textNotContainsPhrase("say \"hi\" \\ bye")`)
+			})
+		})
+	})
+
+	Convey("Given a regular expression anchored at both ends", t, func() {
+		regex := "^foo.*bar$"
+		Convey("When 'TextContainsRegex' is called", func() {
+			result := TextContainsRegex(regex)
+			Convey("Then the end anchor should be escaped", func() {
+				So(result.String(), ShouldEqual, `textContainsRegex("^foo.*bar\$")`)
+			})
+		})
+
+		Convey("When 'TextNotContainsRegex' is called", func() {
+			result := TextNotContainsRegex(regex)
+			Convey("Then the end anchor should be escaped", func() {
+				So(result.String(), ShouldEqual, `textNotContainsRegex("^foo.*bar\$")`)
+			})
+		})
+	})
+
+	Convey("Given a binding", t, func() {
+		Convey("When 'TextContains' is called", func() {
+			result := TextContains(binding("prefix"))
+			Convey("Then the binding should be written as is", func() {
+				So(result.String(), ShouldEqual, "textContains(prefix)")
+			})
+		})
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package sourceoption contains the keys that configure a traversal source with with().
+
+See:
+
+	TinkerPop: https://tinkerpop.apache.org/docs/current/reference/#start-steps
+	Neptune: https://docs.aws.amazon.com/neptune/latest/userguide/gremlin-query-hints.html
+
+The keys are strings so they can be given to Source.With() together
+with keys that are not listed here:
+
+	traversal.NewSource().With(sourceoption.NeptuneEnableResultCache, true).V()
+
+JanusGraph sources are configured with strategies and with
+withComputer() instead, such as computer.FulgoraGraphComputer.
+*/
+package sourceoption
+
+const (
+	// EvaluationTimeout is the number of milliseconds the
+	// server may spend on the traversal before it is cancelled.
+	EvaluationTimeout = "evaluationTimeout"
+)
+
+// Neptune result cache and query engine options.
+const (
+	// NeptuneEnableResultCache caches the results of the traversal.
+	NeptuneEnableResultCache = "Neptune#enableResultCache"
+	// NeptuneEnableResultCacheWithTTL caches the results
+	// of the traversal for the given number of seconds.
+	NeptuneEnableResultCacheWithTTL = "Neptune#enableResultCacheWithTTL"
+	// NeptuneInvalidateResultCache clears the whole result cache.
+	NeptuneInvalidateResultCache = "Neptune#invalidateResultCache"
+	// NeptuneInvalidateResultCacheKey clears the cached
+	// results of the traversal before it is run.
+	NeptuneInvalidateResultCacheKey = "Neptune#invalidateResultCacheKey"
+	// NeptuneNumResultsCached is the most results that are cached.
+	NeptuneNumResultsCached = "Neptune#numResultsCached"
+	// NeptuneUseDFE runs the traversal on the DFE query engine.
+	NeptuneUseDFE = "Neptune#useDFE"
+)
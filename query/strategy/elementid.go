@@ -0,0 +1,45 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package strategy
+
+// https://tinkerpop.apache.org/docs/current/reference/#elementidstrategy
+
+// ElementIDBuilder configures an ElementIdStrategy, which
+// uses a property as the identifier of the elements.
+type ElementIDBuilder struct {
+	b builder
+}
+
+// ElementID starts a new ElementIdStrategy.
+func ElementID() ElementIDBuilder {
+	return ElementIDBuilder{b: builder{name: ElementIdStrategy}}
+}
+
+// IDPropertyKey sets the property key that holds the identifier.
+func (e ElementIDBuilder) IDPropertyKey(key string) ElementIDBuilder {
+	e.b = e.b.with("idPropertyKey", key)
+	return e
+}
+
+// Create returns the configured strategy.
+func (e ElementIDBuilder) Create() Strategy {
+	return e.b.create()
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package strategy
+
+// https://tinkerpop.apache.org/docs/current/reference/#optionsstrategy
+
+// OptionsBuilder configures an OptionsStrategy, which carries
+// options through the traversal for the graph provider.
+type OptionsBuilder struct {
+	b builder
+}
+
+// Options starts a new OptionsStrategy.
+func Options() OptionsBuilder {
+	return OptionsBuilder{b: builder{name: OptionsStrategy}}
+}
+
+// With sets an option. A key on its own is set to true.
+func (o OptionsBuilder) With(key string, value ...interface{}) OptionsBuilder {
+	o.b = o.b.with("with", append([]interface{}{key}, value...)...)
+	return o
+}
+
+// Create returns the configured strategy.
+func (o OptionsBuilder) Create() Strategy {
+	return o.b.create()
+}
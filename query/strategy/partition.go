@@ -0,0 +1,69 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package strategy
+
+// https://tinkerpop.apache.org/docs/current/reference/#partitionstrategy
+
+// PartitionBuilder configures a PartitionStrategy, which
+// splits the graph into partitions using a property key.
+type PartitionBuilder struct {
+	b builder
+}
+
+// Partition starts a new PartitionStrategy.
+func Partition() PartitionBuilder {
+	return PartitionBuilder{b: builder{name: PartitionStrategy}}
+}
+
+// PartitionKey sets the property key that holds the partition.
+func (p PartitionBuilder) PartitionKey(key string) PartitionBuilder {
+	p.b = p.b.with("partitionKey", key)
+	return p
+}
+
+// WritePartition sets the partition new elements are written to.
+func (p PartitionBuilder) WritePartition(partition string) PartitionBuilder {
+	p.b = p.b.with("writePartition", partition)
+	return p
+}
+
+// ReadPartitions sets the partitions that can be read.
+func (p PartitionBuilder) ReadPartitions(partitions ...string) PartitionBuilder {
+	args := make([]interface{}, 0, len(partitions))
+	for _, v := range partitions {
+		args = append(args, v)
+	}
+
+	p.b = p.b.with("readPartitions", args...)
+	return p
+}
+
+// IncludeMetaProperties determines whether vertex
+// properties are partitioned along with the elements.
+func (p PartitionBuilder) IncludeMetaProperties(include bool) PartitionBuilder {
+	p.b = p.b.with("includeMetaProperties", include)
+	return p
+}
+
+// Create returns the configured strategy.
+func (p PartitionBuilder) Create() Strategy {
+	return p.b.create()
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package strategy contains the objects to configure the traversal strategies of a graph traversal source.
+
+See: https://tinkerpop.apache.org/docs/current/reference/#traversalstrategy
+
+Strategies are added to a traversal source with WithStrategies() and
+removed from it with WithoutStrategies(). The strategies that take
+options are made with a builder such as Subgraph() or Partition().
+
+A note about Strategy and Name:
+
+These objects implement the Parameter interfaces used by graph traversals.
+*/
+package strategy
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// Strategy is a configured traversal strategy that
+// can be given to the WithStrategies() source step.
+type Strategy string
+
+func (s Strategy) String() string {
+	return string(s)
+}
+
+// Name is the class name of a traversal strategy. Names
+// are given to the WithoutStrategies() source step.
+type Name string
+
+const (
+	// ConnectiveStrategy rewrites and() and or() chains.
+	ConnectiveStrategy Name = "ConnectiveStrategy"
+	// EdgeLabelVerificationStrategy warns or fails on edge steps without labels.
+	EdgeLabelVerificationStrategy Name = "EdgeLabelVerificationStrategy"
+	// ElementIdStrategy uses a property as the identifier of elements.
+	ElementIdStrategy Name = "ElementIdStrategy"
+	// HaltedTraverserStrategy controls what halted traversers are detached as.
+	HaltedTraverserStrategy Name = "HaltedTraverserStrategy"
+	// LambdaRestrictionStrategy stops traversals that use lambdas.
+	LambdaRestrictionStrategy Name = "LambdaRestrictionStrategy"
+	// OptionsStrategy carries options to the traversal for the provider.
+	OptionsStrategy Name = "OptionsStrategy"
+	// PartitionStrategy splits the graph into partitions by a property.
+	PartitionStrategy Name = "PartitionStrategy"
+	// ProductiveByStrategy filters by() modulators that return nothing.
+	ProductiveByStrategy Name = "ProductiveByStrategy"
+	// ReadOnlyStrategy stops traversals that change the graph.
+	ReadOnlyStrategy Name = "ReadOnlyStrategy"
+	// ReservedKeysVerificationStrategy warns or fails when reserved property keys are used.
+	ReservedKeysVerificationStrategy Name = "ReservedKeysVerificationStrategy"
+	// SeedStrategy makes the random steps like coin() and sample() repeatable.
+	SeedStrategy Name = "SeedStrategy"
+	// SubgraphStrategy limits the traversal to a subgraph.
+	SubgraphStrategy Name = "SubgraphStrategy"
+
+	// AdjacentToIncidentStrategy is an optimization strategy.
+	AdjacentToIncidentStrategy Name = "AdjacentToIncidentStrategy"
+	// CountStrategy is an optimization strategy.
+	CountStrategy Name = "CountStrategy"
+	// EarlyLimitStrategy is an optimization strategy.
+	EarlyLimitStrategy Name = "EarlyLimitStrategy"
+	// FilterRankingStrategy is an optimization strategy.
+	FilterRankingStrategy Name = "FilterRankingStrategy"
+	// IdentityRemovalStrategy is an optimization strategy.
+	IdentityRemovalStrategy Name = "IdentityRemovalStrategy"
+	// IncidentToAdjacentStrategy is an optimization strategy.
+	IncidentToAdjacentStrategy Name = "IncidentToAdjacentStrategy"
+	// InlineFilterStrategy is an optimization strategy.
+	InlineFilterStrategy Name = "InlineFilterStrategy"
+	// LazyBarrierStrategy is an optimization strategy.
+	LazyBarrierStrategy Name = "LazyBarrierStrategy"
+	// MatchPredicateStrategy is an optimization strategy.
+	MatchPredicateStrategy Name = "MatchPredicateStrategy"
+	// OrderLimitStrategy is an optimization strategy.
+	OrderLimitStrategy Name = "OrderLimitStrategy"
+	// PathProcessorStrategy is an optimization strategy.
+	PathProcessorStrategy Name = "PathProcessorStrategy"
+	// PathRetractionStrategy is an optimization strategy.
+	PathRetractionStrategy Name = "PathRetractionStrategy"
+	// RepeatUnrollStrategy is an optimization strategy.
+	RepeatUnrollStrategy Name = "RepeatUnrollStrategy"
+
+	// ComputerVerificationStrategy is a verification strategy.
+	ComputerVerificationStrategy Name = "ComputerVerificationStrategy"
+	// StandardVerificationStrategy is a verification strategy.
+	StandardVerificationStrategy Name = "StandardVerificationStrategy"
+)
+
+func (n Name) String() string {
+	return string(n)
+}
+
+// Instance returns the strategy of the given name
+// for strategies that don't take any options.
+func Instance(n Name) Strategy {
+	return Strategy(n.String() + ".instance()")
+}
+
+// ReadOnly returns the ReadOnlyStrategy that stops
+// any traversal that would change the graph.
+func ReadOnly() Strategy {
+	return Instance(ReadOnlyStrategy)
+}
+
+// LambdaRestriction returns the LambdaRestrictionStrategy
+// that stops any traversal that uses a lambda.
+func LambdaRestriction() Strategy {
+	return Instance(LambdaRestrictionStrategy)
+}
+
+// builder renders the build().<option>(...).create()
+// form that configurable strategies are made with.
+type builder struct {
+	name    Name
+	options []string
+}
+
+// with returns a copy of the builder with the option
+// added so that builders can be shared and reused.
+func (b builder) with(option string, args ...interface{}) builder {
+	rendered := make([]string, 0, len(args))
+	for _, a := range args {
+		rendered = append(rendered, literal.Arg(a))
+	}
+
+	options := make([]string, len(b.options), len(b.options)+1)
+	copy(options, b.options)
+	b.options = append(options, option+"("+strings.Join(rendered, ",")+")")
+
+	return b
+}
+
+func (b builder) create() Strategy {
+	buf := bytes.NewBufferString(b.name.String() + ".build()")
+
+	for _, o := range b.options {
+		buf.WriteString("." + o)
+	}
+
+	buf.WriteString(".create()")
+
+	return Strategy(buf.String())
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package strategy
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type anonymous string
+
+func (a anonymous) String() string { return string(a) }
+
+func TestInstance(t *testing.T) {
+	Convey("Given a strategy without options", t, func() {
+		Convey("When 'ReadOnly' is called", func() {
+			Convey("Then the instance should be rendered", func() {
+				So(ReadOnly().String(), ShouldEqual, "ReadOnlyStrategy.instance()")
+			})
+		})
+
+		Convey("When 'LambdaRestriction' is called", func() {
+			Convey("Then the instance should be rendered", func() {
+				So(LambdaRestriction().String(), ShouldEqual, "LambdaRestrictionStrategy.instance()")
+			})
+		})
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	Convey("Given a strategy builder", t, func() {
+		base := Partition().PartitionKey("_partition")
+		Convey("When two strategies are made from the same builder", func() {
+			a := base.WritePartition("a").Create()
+			b := base.WritePartition("b").Create()
+			Convey("Then they should not share options", func() {
+				So(a.String(), ShouldEqual, "PartitionStrategy.build().partitionKey(\"_partition\").writePartition(\"a\").create()")
+				So(b.String(), ShouldEqual, "PartitionStrategy.build().partitionKey(\"_partition\").writePartition(\"b\").create()")
+			})
+		})
+
+		Convey("When an option is a string with quotes", func() {
+			s := base.WritePartition("say \"hi\"").Create()
+			Convey("Then the quotes should be escaped", func() {
+				So(s.String(), ShouldEqual, "PartitionStrategy.build().partitionKey(\"_partition\").writePartition(\"say \\\"hi\\\"\").create()")
+			})
+		})
+
+		Convey("When an option is a string with a dollar sign", func() {
+			s := base.WritePartition("${tenant}").ReadPartitions("a$b").Create()
+			Convey("Then the dollar signs should be escaped so the partition isn't interpolated", func() {
+				So(s.String(), ShouldEqual, `PartitionStrategy.build().partitionKey("_partition").writePartition("\${tenant}").readPartitions("a\$b").create()`)
+			})
+		})
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package strategy
+
+import "fmt"
+
+// https://tinkerpop.apache.org/docs/current/reference/#subgraphstrategy
+
+// SubgraphBuilder configures a SubgraphStrategy, which
+// limits a traversal to the elements that pass its filters.
+type SubgraphBuilder struct {
+	b builder
+}
+
+// Subgraph starts a new SubgraphStrategy. The filters are
+// anonymous traversals such as
+// traversal.NewTraversal().HasLabel("person").Raw().
+func Subgraph() SubgraphBuilder {
+	return SubgraphBuilder{b: builder{name: SubgraphStrategy}}
+}
+
+// Vertices sets the filter the vertices must pass.
+func (s SubgraphBuilder) Vertices(filter fmt.Stringer) SubgraphBuilder {
+	s.b = s.b.with("vertices", filter)
+	return s
+}
+
+// Edges sets the filter the edges must pass.
+func (s SubgraphBuilder) Edges(filter fmt.Stringer) SubgraphBuilder {
+	s.b = s.b.with("edges", filter)
+	return s
+}
+
+// VertexProperties sets the filter the vertex properties must pass.
+func (s SubgraphBuilder) VertexProperties(filter fmt.Stringer) SubgraphBuilder {
+	s.b = s.b.with("vertexProperties", filter)
+	return s
+}
+
+// CheckAdjacentVertices determines whether the vertices of an edge
+// must also pass the vertex filter for the edge to be traversed.
+func (s SubgraphBuilder) CheckAdjacentVertices(check bool) SubgraphBuilder {
+	s.b = s.b.with("checkAdjacentVertices", check)
+	return s
+}
+
+// Create returns the configured strategy.
+func (s SubgraphBuilder) Create() Strategy {
+	return s.b.create()
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package strategy
+
+// https://tinkerpop.apache.org/docs/current/reference/#_edgelabelverificationstrategy
+
+// VerificationBuilder configures an EdgeLabelVerificationStrategy
+// or a ReservedKeysVerificationStrategy.
+type VerificationBuilder struct {
+	b builder
+}
+
+// EdgeLabelVerification starts a new EdgeLabelVerificationStrategy,
+// which reports edge steps that don't name any edge labels.
+func EdgeLabelVerification() VerificationBuilder {
+	return VerificationBuilder{b: builder{name: EdgeLabelVerificationStrategy}}
+}
+
+// ReservedKeysVerification starts a new ReservedKeysVerificationStrategy,
+// which reports properties that use one of the reserved keys
+// of the server, which are "id" and "label" by default.
+func ReservedKeysVerification() VerificationBuilder {
+	return VerificationBuilder{b: builder{name: ReservedKeysVerificationStrategy}}
+}
+
+// LogWarning determines whether a warning is logged.
+func (v VerificationBuilder) LogWarning(log bool) VerificationBuilder {
+	v.b = v.b.with("logWarning", log)
+	return v
+}
+
+// ThrowException determines whether the traversal fails.
+func (v VerificationBuilder) ThrowException(throw bool) VerificationBuilder {
+	v.b = v.b.with("throwException", throw)
+	return v
+}
+
+// Create returns the configured strategy.
+func (v VerificationBuilder) Create() Strategy {
+	return v.b.create()
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package strategy
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVerification(t *testing.T) {
+	Convey("Given the verification strategy builders", t, func() {
+		Convey("When an EdgeLabelVerificationStrategy is made", func() {
+			s := EdgeLabelVerification().LogWarning(true).ThrowException(false).Create()
+			Convey("Then the strategy should be rendered with each option", func() {
+				So(s.String(), ShouldEqual, "EdgeLabelVerificationStrategy.build().logWarning(true).throwException(false).create()")
+			})
+		})
+
+		Convey("When a ReservedKeysVerificationStrategy is made", func() {
+			s := ReservedKeysVerification().ThrowException(true).Create()
+			Convey("Then the strategy should be rendered with each option", func() {
+				So(s.String(), ShouldEqual, "ReservedKeysVerificationStrategy.build().throwException(true).create()")
+			})
+		})
+	})
+}
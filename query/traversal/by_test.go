@@ -24,6 +24,9 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/order"
+	"github.com/northwesternmutual/grammes/query/token"
 )
 
 func TestBy(t *testing.T) {
@@ -55,5 +58,18 @@ func TestBy(t *testing.T) {
 				So(result.String(), ShouldEqual, "g.by(\"id\",desc)")
 			})
 		})
+
+		Convey("When 'By' is called with a key and an Order", func() {
+			result := g.By("age", order.Desc)
+			Convey("Then result should equal 'g.by(\"age\",Order.desc)'", func() {
+				So(result.String(), ShouldEqual, "g.by(\"age\",Order.desc)")
+			})
+		})
+		Convey("When 'By' is called with a Token and an Order", func() {
+			result := g.By(token.ID, order.Shuffle)
+			Convey("Then result should equal 'g.by(T.id,Order.shuffle)'", func() {
+				So(result.String(), ShouldEqual, "g.by(T.id,Order.shuffle)")
+			})
+		})
 	})
 }
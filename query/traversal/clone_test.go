@@ -0,0 +1,115 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClone(t *testing.T) {
+	Convey("Given a base traversal", t, func() {
+		base := NewTraversal().V().HasLabel("person")
+		Convey("When 'Clone' is called", func() {
+			result := base.Clone()
+			Convey("Then result should equal the base", func() {
+				So(result.String(), ShouldEqual, base.String())
+			})
+			Convey("Then result should not share a buffer with the base", func() {
+				So(result.buffer, ShouldNotPointTo, base.buffer)
+			})
+		})
+
+		Convey("When the clones are extended", func() {
+			names := base.Clone().Values("name")
+			ages := base.Clone().Values("age")
+			Convey("Then each clone should only contain its own steps", func() {
+				So(names.String(), ShouldEqual, "g.V().hasLabel(\"person\").values(\"name\")")
+				So(ages.String(), ShouldEqual, "g.V().hasLabel(\"person\").values(\"age\")")
+			})
+			Convey("Then the base should be unchanged", func() {
+				So(base.String(), ShouldEqual, "g.V().hasLabel(\"person\")")
+			})
+		})
+
+		Convey("When AddStep is called on a copy of the base", func() {
+			fork := base
+			fork.AddStep("limit", 1)
+			Convey("Then the base should be unchanged", func() {
+				So(base.String(), ShouldEqual, "g.V().hasLabel(\"person\")")
+				So(fork.String(), ShouldEqual, "g.V().hasLabel(\"person\").limit(1)")
+			})
+		})
+	})
+}
+
+func TestCloneConcurrent(t *testing.T) {
+	Convey("Given a base traversal shared between goroutines", t, func() {
+		base := NewTraversal().V().HasLabel("person")
+		Convey("When many goroutines build from clones of the base", func() {
+			results := buildConcurrently(func(i int) String {
+				return base.Clone().Has("age", i).Values("name")
+			})
+			Convey("Then every result should only contain its own steps", func() {
+				for i, r := range results {
+					So(r, ShouldEqual, "g.V().hasLabel(\"person\").has(\"age\","+strconv.Itoa(i)+").values(\"name\")")
+				}
+			})
+		})
+
+		Convey("When many goroutines build from plain copies of the base", func() {
+			results := buildConcurrently(func(i int) String {
+				fork := base
+				fork.AddStep("has", "age", i)
+				return fork.Limit(i)
+			})
+			Convey("Then every result should only contain its own steps", func() {
+				for i, r := range results {
+					So(r, ShouldEqual, "g.V().hasLabel(\"person\").has(\"age\","+strconv.Itoa(i)+").limit("+strconv.Itoa(i)+")")
+				}
+			})
+		})
+	})
+}
+
+// buildConcurrently runs build in its own goroutine for
+// every index and gathers the resulting query strings.
+func buildConcurrently(build func(int) String) []string {
+	var (
+		wg      sync.WaitGroup
+		results = make([]string, 64)
+	)
+
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = build(i).String()
+		}(i)
+	}
+
+	wg.Wait()
+
+	return results
+}
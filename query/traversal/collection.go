@@ -0,0 +1,119 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"bytes"
+	"sort"
+)
+
+// List is a Groovy list literal such as [1,2,3].
+type List []interface{}
+
+func (l List) String() string {
+	buf := bytes.NewBufferString("[")
+
+	for i, v := range l {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(renderArg(v))
+	}
+
+	buf.WriteString("]")
+
+	return buf.String()
+}
+
+// toList turns a slice of strings into a List.
+func toList(strs []string) List {
+	l := make(List, 0, len(strs))
+	for _, s := range strs {
+		l = append(l, s)
+	}
+
+	return l
+}
+
+// Map is a Groovy map literal such as [(T.label):"person","name":"damien"].
+// The entries keep their order so a parsed map renders as it was written.
+// Go maps given to a step are rendered as a Map sorted by their keys.
+type Map []MapEntry
+
+// MapEntry is a single key and value of a Map.
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+func (m Map) String() string {
+	if len(m) == 0 {
+		return "[:]"
+	}
+
+	buf := bytes.NewBufferString("[")
+
+	for i, e := range m {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(renderKey(e.Key) + ":" + renderArg(e.Value))
+	}
+
+	buf.WriteString("]")
+
+	return buf.String()
+}
+
+// renderKey renders a map key. Keys that aren't literals, such
+// as T.label, are wrapped in parentheses so Groovy evaluates them
+// rather than treating them as a string.
+func renderKey(k interface{}) string {
+	switch k.(type) {
+	case string, Number, bool, int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, float32, float64:
+		return renderArg(k)
+	default:
+		return "(" + renderArg(k) + ")"
+	}
+}
+
+// toMap turns a Go map into a Map sorted by its rendered keys.
+func toMap(m interface{}) Map {
+	var res Map
+
+	switch t := m.(type) {
+	case map[string]interface{}:
+		for k, v := range t {
+			res = append(res, MapEntry{Key: k, Value: v})
+		}
+	case map[interface{}]interface{}:
+		for k, v := range t {
+			res = append(res, MapEntry{Key: k, Value: v})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return renderKey(res[i].Key) < renderKey(res[j].Key)
+	})
+
+	return res
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/computer"
+)
+
+func TestConnectedComponent(t *testing.T) {
+	Convey("Given a ) String { that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+		Convey("When 'ConnectedComponent' is called", func() {
+			result := g.ConnectedComponent()
+			Convey("Then result should equal 'g.connectedComponent()'", func() {
+				So(result.String(), ShouldEqual, "g.connectedComponent()")
+			})
+		})
+
+		Convey("When 'ConnectedComponent' is configured with 'With'", func() {
+			result := g.WithComputer().V().ConnectedComponent().With(computer.ConnectedComponentPropertyName, "component")
+			Convey("Then the option should be rendered", func() {
+				So(result.String(), ShouldEqual, "g.withComputer().V().connectedComponent().with(ConnectedComponent.propertyName,\"component\")")
+			})
+		})
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/dialect"
+)
+
+// idSteps are the steps whose arguments are element IDs.
+var idSteps = stepSet("V", "E", "hasId")
+
+// Render adapts the traversal to the given provider. Steps and
+// lambdas that the provider doesn't support are rejected with an error and
+// ID literals are rewritten into the format the provider expects.
+func (g String) Render(d dialect.Dialect) (String, error) {
+	if d == dialect.Generic {
+		return g, nil
+	}
+
+	s, err := Parse(g.String())
+	if err != nil {
+		return g, gremerror.NewValidationError("", 0, err.Error())
+	}
+
+	if s, err = s.Render(d); err != nil {
+		return g, err
+	}
+
+	return s.Traversal(), nil
+}
+
+// Render adapts the script and its nested traversals to the given
+// provider. Scripts using the graph object are rejected for
+// providers without the JanusGraph management API.
+func (s Script) Render(d dialect.Dialect) (Script, error) {
+	if s.Source == "graph" && !d.SupportsManagement() && len(s.Steps) > 0 {
+		return s, gremerror.NewValidationError(s.Steps[0].Name, 0,
+			"the graph object is not supported by "+d.Name())
+	}
+
+	rendered := Script{Source: s.Source, Steps: make([]Step, len(s.Steps))}
+
+	for i, step := range s.Steps {
+		if !d.Supports(step.Name) {
+			return s, gremerror.NewValidationError(step.Name, i,
+				step.Name+"() is not supported by "+d.Name())
+		}
+
+		args := make([]interface{}, len(step.Args))
+
+		for j, a := range step.Args {
+			if _, ok := a.(Lambda); ok && !d.SupportsLambdas() {
+				return s, gremerror.NewValidationError(step.Name, i,
+					"lambdas are not supported by "+d.Name())
+			}
+
+			nested, ok := a.(Script)
+			if !ok {
+				if idSteps[step.Name] {
+					a = renderID(a, d)
+				}
+				args[j] = a
+				continue
+			}
+
+			if idSteps[step.Name] && scriptKind(nested) == kindPredicate {
+				// predicates such as hasId(within(1,2)) hold IDs as well.
+				nested = renderPredicateIDs(nested, d)
+			}
+
+			var err error
+			if args[j], err = nested.Render(d); err != nil {
+				return s, err
+			}
+		}
+
+		rendered.Steps[i] = Step{Name: step.Name, Args: args}
+	}
+
+	return rendered, nil
+}
+
+// renderPredicateIDs rewrites the ID literals given to a predicate.
+func renderPredicateIDs(p Script, d dialect.Dialect) Script {
+	rendered := Script{Source: p.Source, Steps: make([]Step, len(p.Steps))}
+
+	for i, step := range p.Steps {
+		args := make([]interface{}, len(step.Args))
+		for j, a := range step.Args {
+			if nested, ok := a.(Script); ok {
+				args[j] = renderPredicateIDs(nested, d)
+				continue
+			}
+			args[j] = renderID(a, d)
+		}
+		rendered.Steps[i] = Step{Name: step.Name, Args: args}
+	}
+
+	return rendered
+}
+
+// renderID rewrites a numeric ID into a string for providers with
+// string IDs or into a long literal for providers that need them.
+func renderID(a interface{}, d dialect.Dialect) interface{} {
+	var digits string
+
+	switch t := a.(type) {
+	case Number:
+		digits = strings.TrimPrefix(t.String(), "-")
+		if digits == "" || strings.Trim(digits, "0123456789") != "" {
+			// decimals and literals with a suffix are left alone.
+			return a
+		}
+		digits = t.String()
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		digits = fmtStr("%d", t)
+	default:
+		return a
+	}
+
+	switch {
+	case d.StringIDs():
+		return digits
+	case d.LongIDs():
+		return Number(digits + "L")
+	default:
+		return a
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/dialect"
+	"github.com/northwesternmutual/grammes/query/predicate"
+)
+
+func TestRender(t *testing.T) {
+	Convey("Given a traversal with numeric IDs", t, func() {
+		g := NewTraversal().V(1).Out().HasID(2).Has("age", 3)
+		Convey("When 'Render' is called with the generic dialect", func() {
+			result, err := g.Render(dialect.Generic)
+			Convey("Then the traversal should be unchanged", func() {
+				So(err, ShouldBeNil)
+				So(result.String(), ShouldEqual, g.String())
+			})
+		})
+
+		Convey("When 'Render' is called for JanusGraph", func() {
+			result, err := g.Render(dialect.JanusGraph)
+			Convey("Then the traversal should be unchanged", func() {
+				So(err, ShouldBeNil)
+				So(result.String(), ShouldEqual, g.String())
+			})
+		})
+
+		Convey("When 'Render' is called for Neptune", func() {
+			result, err := g.Render(dialect.Neptune)
+			Convey("Then only the IDs should be rendered as strings", func() {
+				So(err, ShouldBeNil)
+				So(result.String(), ShouldEqual, "g.V(\"1\").out().hasId(\"2\").has(\"age\",3)")
+			})
+		})
+
+		Convey("When 'Render' is called for TinkerGraph", func() {
+			result, err := g.Render(dialect.TinkerGraph)
+			Convey("Then only the IDs should be rendered as longs", func() {
+				So(err, ShouldBeNil)
+				So(result.String(), ShouldEqual, "g.V(1L).out().hasId(2L).has(\"age\",3)")
+			})
+		})
+	})
+
+	Convey("Given a traversal with IDs inside of a predicate and a nested traversal", t, func() {
+		g := NewTraversal().V().HasID(predicate.Within(1, 2)).Where(NewTraversal().Out().HasID(3).Raw())
+		Convey("When 'Render' is called for Cosmos DB", func() {
+			result, err := g.Render(dialect.CosmosDB)
+			Convey("Then every ID should be rendered as a string", func() {
+				So(err, ShouldBeNil)
+				So(result.String(), ShouldEqual, "g.V().hasId(within(\"1\",\"2\")).where(out().hasId(\"3\"))")
+			})
+		})
+	})
+
+	Convey("Given a traversal with a step Cosmos DB doesn't support", t, func() {
+		g := NewTraversal().V().Local(NewTraversal().Values("age").Math("_ + 1").Raw())
+		Convey("When 'Render' is called for Cosmos DB", func() {
+			_, err := g.Render(dialect.CosmosDB)
+			Convey("Then an error naming the step should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "math() is not supported by Cosmos DB")
+			})
+		})
+
+		Convey("When 'Render' is called for JanusGraph", func() {
+			_, err := g.Render(dialect.JanusGraph)
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a traversal with a step Neptune doesn't support", t, func() {
+		g := NewTraversal().V().PageRank()
+		Convey("When 'Render' is called for Neptune", func() {
+			_, err := g.Render(dialect.Neptune)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a management script", t, func() {
+		s, _ := Parse("graph.openManagement().makeVertexLabel(\"person\").make()")
+		Convey("When 'Render' is called for Neptune", func() {
+			_, err := s.Render(dialect.Neptune)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When 'Render' is called for JanusGraph", func() {
+			_, err := s.Render(dialect.JanusGraph)
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a traversal that cannot be parsed", t, func() {
+		g := NewCustomTraversal("g.V(")
+		Convey("When 'Render' is called for Neptune", func() {
+			_, err := g.Render(dialect.Neptune)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
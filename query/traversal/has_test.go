@@ -78,6 +78,25 @@ func TestHas(t *testing.T) {
 	})
 }
 
+func TestHasPredicate(t *testing.T) {
+	Convey("Given a ) String { that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+		Convey("When 'Has' is called with a TextP predicate", func() {
+			result := g.Has("name", predicate.StartingWith("da").And(predicate.NotEndingWith("x")))
+			Convey("Then result should equal 'g.has('name',startingWith('da').and(notEndingWith('x')))'", func() {
+				So(result.String(), ShouldEqual, "g.has(\"name\",startingWith(\"da\").and(notEndingWith(\"x\")))")
+			})
+		})
+
+		Convey("When 'Has' is called with a bound predicate", func() {
+			result := g.Has("age", predicate.Between(Custom("low"), Custom("high")))
+			Convey("Then result should equal 'g.has('age',between(low,high))'", func() {
+				So(result.String(), ShouldEqual, "g.has(\"age\",between(low,high))")
+			})
+		})
+	})
+}
+
 func TestHasID(t *testing.T) {
 	Convey("Given a ) String { that represents the graph's traversal", t, func() {
 		g := NewTraversal()
@@ -94,6 +113,13 @@ func TestHasID(t *testing.T) {
 				So(result.String(), ShouldEqual, "g.hasId(\"tstObjOrP\",\"tstObj1\",\"tstObj2\")")
 			})
 		})
+
+		Convey("When 'HasID' is called with a predicate", func() {
+			result := g.HasID(predicate.Without(1, 2))
+			Convey("Then result should equal 'g.hasId(without(1,2))'", func() {
+				So(result.String(), ShouldEqual, "g.hasId(without(1,2))")
+			})
+		})
 	})
 }
 
@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/predicate"
 )
 
 func TestIs(t *testing.T) {
@@ -35,5 +37,12 @@ func TestIs(t *testing.T) {
 				So(result.String(), ShouldEqual, "g.is(\"testStr\")")
 			})
 		})
+
+		Convey("When 'Is' is called with a chained predicate", func() {
+			result := g.Is(predicate.Between(1, 5).Or(predicate.Outside(10, 20)))
+			Convey("Then result should equal 'g.is(between(1,5).or(outside(10,20)))'", func() {
+				So(result.String(), ShouldEqual, "g.is(between(1,5).or(outside(10,20)))")
+			})
+		})
 	})
 }
@@ -0,0 +1,108 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// http://tinkerpop.apache.org/docs/current/reference/#a-note-on-lambdas
+
+const (
+	// GroovyLanguage is the language of Groovy closures such as { it.get() }.
+	GroovyLanguage = "gremlin-groovy"
+	// JavaLanguage is the language of Java-style lambdas such as x -> x.get().
+	JavaLanguage = "gremlin-java"
+)
+
+// Lambda is a closure given to steps such as Map(), Filter(),
+// SideEffect(), By() or Sack() in place of a traversal. Lambdas
+// are written into the script as they are, so they are never quoted.
+// Providers that don't run lambdas, such as Neptune and Cosmos DB,
+// reject them when the traversal is rendered for their dialect.
+type Lambda struct {
+	Script   string
+	Language string
+}
+
+// NewLambda returns a Groovy closure. The braces
+// around the script may be left out.
+// Example:
+//
+//	NewLambda("it.get().value('name').length()")
+//	// ==> {it.get().value('name').length()}
+func NewLambda(script string) Lambda {
+	script = strings.TrimSpace(script)
+
+	if strings.HasPrefix(script, "{") && strings.HasSuffix(script, "}") {
+		script = strings.TrimSpace(script[1 : len(script)-1])
+	}
+
+	return Lambda{Script: script, Language: GroovyLanguage}
+}
+
+// NewJavaLambda returns a Java-style lambda
+// such as "x -> x.get().value('name')".
+func NewJavaLambda(script string) Lambda {
+	return Lambda{Script: strings.TrimSpace(script), Language: JavaLanguage}
+}
+
+func (l Lambda) String() string {
+	if l.Language == JavaLanguage {
+		return l.Script
+	}
+	return "{" + l.Script + "}"
+}
+
+// Arguments returns the number of arguments the lambda declares
+// or -1 when it doesn't declare them, like a closure using "it".
+func (l Lambda) Arguments() int {
+	arrow := strings.Index(l.Script, "->")
+	if arrow < 0 {
+		return -1
+	}
+
+	params := strings.Trim(strings.TrimSpace(l.Script[:arrow]), "()")
+	if strings.TrimSpace(params) == "" {
+		return 0
+	}
+
+	// an arrow after anything but parameter names
+	// belongs to a closure nested in the script.
+	if strings.Trim(params, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_, ") != "" {
+		return -1
+	}
+
+	return strings.Count(params, ",") + 1
+}
+
+// MarshalJSON serializes the lambda as a GraphSON g:Lambda.
+func (l Lambda) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"@type": "g:Lambda",
+		"@value": map[string]interface{}{
+			"script":    l.String(),
+			"language":  l.Language,
+			"arguments": l.Arguments(),
+		},
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/dialect"
+)
+
+func TestLambda(t *testing.T) {
+	Convey("Given a ) String { that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+		Convey("When 'Map' is called with a Groovy closure", func() {
+			result := g.V().Map(NewLambda("it.get().value('name')"))
+			Convey("Then result should equal 'g.V().map({it.get().value('name')})'", func() {
+				So(result.String(), ShouldEqual, "g.V().map({it.get().value('name')})")
+			})
+		})
+
+		Convey("When 'By' is called with a Groovy closure in braces", func() {
+			result := g.V().Order().By(NewLambda("{ a, b -> a <=> b }"))
+			Convey("Then result should equal 'g.V().order().by({a, b -> a <=> b})'", func() {
+				So(result.String(), ShouldEqual, "g.V().order().by({a, b -> a <=> b})")
+			})
+		})
+
+		Convey("When 'Filter' is called with a Java-style lambda", func() {
+			result := g.V().Filter(NewJavaLambda("x -> x.get().label() == \"person\""))
+			Convey("Then the lambda should not be quoted", func() {
+				So(result.String(), ShouldEqual, "g.V().filter(x -> x.get().label() == \"person\")")
+			})
+		})
+	})
+}
+
+func TestLambdaArguments(t *testing.T) {
+	Convey("Given lambdas", t, func() {
+		Convey("When the lambda doesn't declare its arguments", func() {
+			Convey("Then -1 should be returned", func() {
+				So(NewLambda("it.get()").Arguments(), ShouldEqual, -1)
+				So(NewLambda("it.get().collect{ x -> x }").Arguments(), ShouldEqual, -1)
+			})
+		})
+
+		Convey("When the lambda declares its arguments", func() {
+			Convey("Then they should be counted", func() {
+				So(NewLambda("a, b -> a + b").Arguments(), ShouldEqual, 2)
+				So(NewJavaLambda("(a, b) -> a + b").Arguments(), ShouldEqual, 2)
+				So(NewJavaLambda("x -> x").Arguments(), ShouldEqual, 1)
+				So(NewJavaLambda("() -> 1").Arguments(), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestLambdaMarshalJSON(t *testing.T) {
+	Convey("Given a Groovy closure", t, func() {
+		l := NewLambda("it.get()")
+		Convey("When it is marshaled", func() {
+			b, err := json.Marshal(l)
+			Convey("Then it should be a GraphSON lambda", func() {
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"@type":"g:Lambda","@value":{"arguments":-1,"language":"gremlin-groovy","script":"{it.get()}"}}`)
+			})
+		})
+	})
+}
+
+func TestParseLambda(t *testing.T) {
+	Convey("Given traversals with lambdas", t, func() {
+		Convey("When a Groovy closure with nested braces and strings is parsed", func() {
+			s, err := Parse(`g.V().filter({ it.get().values("a}").collect{ x -> x } }).count()`)
+			Convey("Then the closure should end at its own brace", func() {
+				So(err, ShouldBeNil)
+				So(s.Steps[1].Args[0], ShouldResemble, NewLambda(`it.get().values("a}").collect{ x -> x }`))
+				So(s.Steps[2].Name, ShouldEqual, "count")
+			})
+		})
+
+		Convey("When a Java-style lambda is parsed", func() {
+			s, err := Parse(`g.V().sideEffect((a, b) -> a.foo(b, 1)).sack(x -> x, "y")`)
+			Convey("Then the lambdas should end with their argument", func() {
+				So(err, ShouldBeNil)
+				So(s.Steps[1].Args[0], ShouldResemble, NewJavaLambda("(a, b) -> a.foo(b, 1)"))
+				So(s.Steps[2].Args, ShouldResemble, []interface{}{NewJavaLambda("x -> x"), "y"})
+			})
+		})
+
+		Convey("When a closure is never closed", func() {
+			_, err := Parse(`g.V().map({ it.get()`)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestRenderLambda(t *testing.T) {
+	Convey("Given a traversal with a lambda", t, func() {
+		g := NewTraversal().V().Map(NewLambda("it.get()"))
+		Convey("When it is rendered for providers that run lambdas", func() {
+			Convey("Then it should be unchanged", func() {
+				for _, d := range []dialect.Dialect{dialect.Generic, dialect.JanusGraph, dialect.TinkerGraph} {
+					r, err := g.Render(d)
+					So(err, ShouldBeNil)
+					So(r.String(), ShouldEqual, g.String())
+				}
+			})
+		})
+
+		Convey("When it is rendered for providers that don't run lambdas", func() {
+			Convey("Then it should be rejected", func() {
+				for _, d := range []dialect.Dialect{dialect.Neptune, dialect.CosmosDB} {
+					_, err := g.Render(d)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, "lambdas are not supported")
+				}
+			})
+		})
+	})
+}
@@ -25,10 +25,14 @@ import "fmt"
 // http://tinkerpop.apache.org/docs/current/reference/#option-step
 
 // Option (step modulator) is an 'option' to a Branch() or Choose()
+// that is taken when the result matches the value or pick.Pick.
 // Signatures:
-// Option(string)
-// Option(string, string)
-func (g String) Option(params ...string) String {
+// Option(string (Object))
+// Option(pick.Pick)
+// Option(string (Object), *String (Traversal))
+// Option(pick.Pick, *String (Traversal))
+// Option(merge.Merge, map[string]interface{})
+func (g String) Option(params ...interface{}) String {
 	if len(params) < 1 {
 		fmt.Println("Not enough parameters to call Option()")
 		return g
@@ -36,15 +40,7 @@ func (g String) Option(params ...string) String {
 		fmt.Println("Too many paramaters to call Option()")
 	}
 
-	g = g.append(".option(\"" + params[0] + "\"")
-
-	if len(params) > 1 {
-		for _, v := range params {
-			g = g.append(",\"" + v + "\"")
-		}
-	}
-
-	g = g.append(")")
+	g.AddStep("option", params...)
 
 	return g
 }
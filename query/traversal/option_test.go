@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/pick"
 )
 
 func TestOption(t *testing.T) {
@@ -45,8 +47,22 @@ func TestOption(t *testing.T) {
 
 		Convey("When 'Option' is called with too many params params", func() {
 			result := g.Option("obj1", "obj2", "obj3")
-			Convey("Then result should equal 'g.option('obj1','obj1','obj2','obj3')'", func() {
-				So(result.String(), ShouldEqual, "g.option(\"obj1\",\"obj1\",\"obj2\",\"obj3\")")
+			Convey("Then result should equal 'g.option('obj1','obj2','obj3')'", func() {
+				So(result.String(), ShouldEqual, "g.option(\"obj1\",\"obj2\",\"obj3\")")
+			})
+		})
+
+		Convey("When 'Option' is called with a value and a traversal", func() {
+			result := g.Option(1, NewTraversal().Out().Raw())
+			Convey("Then result should equal 'g.option(1,out())'", func() {
+				So(result.String(), ShouldEqual, "g.option(1,out())")
+			})
+		})
+
+		Convey("When 'Option' is called with a Pick", func() {
+			result := g.Option(pick.None, NewTraversal().Identity().Raw())
+			Convey("Then result should equal 'g.option(Pick.none,identity())'", func() {
+				So(result.String(), ShouldEqual, "g.option(Pick.none,identity())")
 			})
 		})
 	})
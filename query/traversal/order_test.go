@@ -23,6 +23,7 @@ package traversal
 import (
 	"testing"
 
+	"github.com/northwesternmutual/grammes/query/order"
 	"github.com/northwesternmutual/grammes/query/scope"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -51,5 +52,12 @@ func TestOrder(t *testing.T) {
 				So(result.String(), ShouldEqual, "g.order()")
 			})
 		})
+
+		Convey("When 'Order' is modulated by an Order", func() {
+			result := g.V().Order().By("name", order.Asc)
+			Convey("Then result should equal 'g.V().order().by(\"name\",Order.asc)'", func() {
+				So(result.String(), ShouldEqual, "g.V().order().by(\"name\",Order.asc)")
+			})
+		})
 	})
 }
@@ -0,0 +1,688 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/query/literal"
+)
+
+// Script is the parsed form of a Gremlin traversal such as
+// the ones built by this package or the graph package.
+//
+// Source holds everything in front of the first step,
+// such as "g", "graph", "__" or "P". It is empty for
+// anonymous traversals like out().in().
+//
+// The arguments of each step are one of:
+//
+//	string  - a string literal.
+//	bool    - true or false.
+//	Number  - a numeric literal, kept exactly as written.
+//	Custom  - an identifier like T.id, local, String.class or a binding.
+//	List    - a list literal like [1,2].
+//	Map     - a map literal like [(T.label):"person"].
+//	Lambda  - a closure like { it.get() } or x -> x.get().
+//	Script  - a nested traversal or predicate like out() or P.eq(1).
+type Script struct {
+	Source string
+	Steps  []Step
+}
+
+// Step is a single step of a parsed traversal.
+type Step struct {
+	Name string
+	Args []interface{}
+}
+
+// Number is a numeric literal found while parsing a
+// traversal. It keeps the original text, including any
+// Groovy suffix such as 1L or 1.5d, so it renders exactly
+// as it was written.
+type Number string
+
+func (n Number) String() string {
+	return string(n)
+}
+
+// NewStep returns a new step with the given arguments
+// to be inserted into a parsed traversal.
+func NewStep(name string, args ...interface{}) Step {
+	return Step{Name: name, Args: args}
+}
+
+// Parse turns a Gremlin string into its steps so it can
+// be validated, rewritten and rendered back into a String.
+// Only the Groovy-flavored subset that this package and
+// the graph package produce is understood.
+//
+// String literals are read as plain text. A ${...} inside a
+// double-quoted string is kept as text and rendered escaped
+// so re-rendering a script never turns a value into code.
+func Parse(script string) (Script, error) {
+	p := &parser{src: script}
+
+	p.skipSpace()
+	v, err := p.parseChain()
+	if err != nil {
+		return Script{}, err
+	}
+
+	p.skipSpace()
+	if !p.eof() {
+		return Script{}, p.errorf("unexpected %q", p.rest())
+	}
+
+	switch t := v.(type) {
+	case Script:
+		return t, nil
+	case Custom:
+		// A lone traversal source such as "g".
+		return Script{Source: t.String()}, nil
+	default:
+		return Script{}, p.errorf("expected a traversal")
+	}
+}
+
+// Traversal renders the script back into a String
+// that may be extended with more steps.
+func (s Script) Traversal() String {
+	return NewCustomTraversal(s.String())
+}
+
+func (s Script) String() string {
+	buf := bytes.NewBufferString(s.Source)
+
+	for i, step := range s.Steps {
+		if i > 0 || s.Source != "" {
+			buf.WriteString(".")
+		}
+		buf.WriteString(step.String())
+	}
+
+	return buf.String()
+}
+
+// Index returns the position of the first step
+// with the given name or -1 if there is none.
+func (s Script) Index(name string) int {
+	for i, step := range s.Steps {
+		if step.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert will add the steps at the given position,
+// moving the step at that position and everything
+// after it to the right. A position before the first
+// step inserts at the start and one past the last
+// step appends, so a bad position never panics.
+//
+//	s.Insert(s.Index("V")+1, NewStep("has", "tenant", "acme"))
+func (s *Script) Insert(i int, steps ...Step) {
+	if i < 0 {
+		i = 0
+	} else if i > len(s.Steps) {
+		i = len(s.Steps)
+	}
+
+	steps = append(append([]Step{}, steps...), s.Steps[i:]...)
+	s.Steps = append(s.Steps[:i:i], steps...)
+}
+
+func (s Step) String() string {
+	buf := bytes.NewBufferString(s.Name + "(")
+
+	for i, a := range s.Args {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(renderArg(a))
+	}
+
+	buf.WriteString(")")
+
+	return buf.String()
+}
+
+// renderArg turns a single step argument back into Gremlin.
+func renderArg(a interface{}) string {
+	switch t := a.(type) {
+	case nil:
+		return "null"
+	case string:
+		return literal.Quote(t)
+	case String:
+		return t.Raw().String()
+	case map[string]interface{}, map[interface{}]interface{}:
+		return toMap(t).String()
+	case []interface{}:
+		return List(t).String()
+	case []string:
+		return toList(t).String()
+	case Parameter:
+		return t.String()
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+// parser is a small recursive descent parser
+// over the raw text of a traversal.
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) rest() string {
+	return p.src[p.pos:]
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("parse error at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() {
+		switch p.peek() {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// expect consumes the given character after any whitespace.
+func (p *parser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		if p.eof() {
+			return p.errorf("expected %q but reached the end", c)
+		}
+		return p.errorf("expected %q but found %q", c, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+// parseChain reads a dotted chain of identifiers and calls.
+// The identifiers in front of the first call make up the
+// source. A chain without any calls is an identifier.
+func (p *parser) parseChain() (interface{}, error) {
+	var path []string
+
+	for {
+		name, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+		if p.peek() == '(' || p.peek() == '{' {
+			first, err := p.parseCall(name)
+			if err != nil {
+				return nil, err
+			}
+			return p.parseSteps(Script{
+				Source: strings.Join(path, "."),
+				Steps:  []Step{first},
+			})
+		}
+
+		path = append(path, name)
+
+		if !p.nextIsDot() {
+			return Custom(strings.Join(path, ".")), nil
+		}
+		p.pos++
+		p.skipSpace()
+	}
+}
+
+// parseSteps reads the remaining .step(...) calls of a chain.
+func (p *parser) parseSteps(s Script) (Script, error) {
+	for p.nextIsDot() {
+		p.pos++
+		p.skipSpace()
+
+		name, err := p.parseIdent()
+		if err != nil {
+			return Script{}, err
+		}
+
+		p.skipSpace()
+		if p.peek() != '{' {
+			if err = p.expect('('); err != nil {
+				return Script{}, err
+			}
+			p.pos--
+		}
+
+		step, err := p.parseCall(name)
+		if err != nil {
+			return Script{}, err
+		}
+
+		s.Steps = append(s.Steps, step)
+	}
+
+	return s, nil
+}
+
+// nextIsDot reports whether the next non-space
+// character is a dot and moves up to it if so.
+func (p *parser) nextIsDot() bool {
+	start := p.pos
+	p.skipSpace()
+	if p.peek() == '.' {
+		return true
+	}
+	p.pos = start
+	return false
+}
+
+func (p *parser) parseIdent() (string, error) {
+	start := p.pos
+
+	for !p.eof() && isIdentByte(p.peek(), p.pos == start) {
+		p.pos++
+	}
+
+	if p.pos == start {
+		if p.eof() {
+			return "", p.errorf("expected an identifier but reached the end")
+		}
+		return "", p.errorf("expected an identifier but found %q", p.peek())
+	}
+
+	return p.src[start:p.pos], nil
+}
+
+func isIdentByte(c byte, first bool) bool {
+	switch {
+	case c == '_' || c == '$':
+		return true
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	default:
+		return false
+	}
+}
+
+// parseCall reads the parenthesized arguments of a step
+// and a trailing closure like map{ it.get() } if it has one.
+func (p *parser) parseCall(name string) (Step, error) {
+	step := Step{Name: name}
+
+	if p.peek() != '{' {
+		if err := p.parseArgs(&step); err != nil {
+			return step, err
+		}
+	}
+
+	start := p.pos
+	p.skipSpace()
+	if p.peek() != '{' {
+		p.pos = start
+		return step, nil
+	}
+
+	closure, err := p.parseClosure()
+	if err != nil {
+		return step, err
+	}
+	step.Args = append(step.Args, closure)
+
+	return step, nil
+}
+
+// parseArgs reads the parenthesized arguments of a step.
+func (p *parser) parseArgs(step *Step) error {
+	name := step.Name
+
+	if err := p.expect('('); err != nil {
+		return err
+	}
+
+	p.skipSpace()
+	if p.peek() == ')' {
+		p.pos++
+		return nil
+	}
+
+	for {
+		arg, err := p.parseValue()
+		if err != nil {
+			return err
+		}
+		step.Args = append(step.Args, arg)
+
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return nil
+		default:
+			if p.eof() {
+				return p.errorf("unclosed arguments for %s()", name)
+			}
+			return p.errorf("unexpected %q in arguments for %s()", p.peek(), name)
+		}
+	}
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	p.skipSpace()
+
+	c := p.peek()
+
+	switch {
+	case c == '"' || c == '\'':
+		return p.parseString()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c == '[':
+		return p.parseCollection()
+	case c == '{':
+		return p.parseClosure()
+	case javaLambda.MatchString(p.rest()):
+		return p.parseJavaLambda()
+	case isIdentByte(c, true):
+		v, err := p.parseChain()
+		if err != nil {
+			return nil, err
+		}
+		switch v {
+		case Custom("true"):
+			return true, nil
+		case Custom("false"):
+			return false, nil
+		}
+		return v, nil
+	case p.eof():
+		return nil, p.errorf("expected a value but reached the end")
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+// javaLambda matches the start of a Java-style
+// lambda such as x -> or (x, y) ->.
+var javaLambda = regexp.MustCompile(`^(\(\s*[\w\s,]*\)|[A-Za-z_]\w*)\s*->`)
+
+// parseClosure reads a Groovy closure like { it.get() }.
+func (p *parser) parseClosure() (interface{}, error) {
+	start := p.pos
+
+	end, err := p.skipBalanced(start+1, func(c byte, depth int) bool {
+		return c == '}' && depth == 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.pos = end + 1
+	return NewLambda(p.src[start : end+1]), nil
+}
+
+// parseJavaLambda reads a Java-style lambda like x -> x.get()
+// which runs until the end of the argument it's given as.
+func (p *parser) parseJavaLambda() (interface{}, error) {
+	start := p.pos
+
+	end, err := p.skipBalanced(start, func(c byte, depth int) bool {
+		return (c == ',' || c == ')' || c == ']') && depth == 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.pos = end
+	return NewJavaLambda(p.src[start:end]), nil
+}
+
+// skipBalanced returns the offset of the first byte from the given
+// offset at which done returns true, skipping over strings and keeping
+// track of how deeply nested the brackets before it are.
+func (p *parser) skipBalanced(from int, done func(c byte, depth int) bool) (int, error) {
+	depth := 0
+
+	for i := from; i < len(p.src); i++ {
+		c := p.src[i]
+
+		switch c {
+		case '"', '\'':
+			// skip to the end of the string.
+			for i++; i < len(p.src) && p.src[i] != c; i++ {
+				if p.src[i] == '\\' {
+					i++
+				}
+			}
+			continue
+		case '(', '[', '{':
+			depth++
+			continue
+		}
+
+		if done(c, depth) {
+			return i, nil
+		}
+
+		if c == ')' || c == ']' || c == '}' {
+			depth--
+		}
+	}
+
+	return 0, p.errorf("unclosed lambda")
+}
+
+// parseCollection reads a list literal like [1,2] or
+// a map literal like ["name":"damien",(T.label):"person"].
+func (p *parser) parseCollection() (interface{}, error) {
+	var (
+		list  List
+		m     Map
+		isMap bool
+	)
+
+	p.pos++
+	p.skipSpace()
+
+	switch {
+	case p.peek() == ']':
+		p.pos++
+		return List{}, nil
+	case strings.HasPrefix(p.rest(), ":"):
+		p.pos++
+		if err := p.expect(']'); err != nil {
+			return nil, err
+		}
+		return Map{}, nil
+	}
+
+	for i := 0; ; i++ {
+		item, paren, err := p.parseItem()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+		if p.peek() == ':' {
+			if i > 0 && !isMap {
+				return nil, p.errorf("unexpected ':' in a list")
+			}
+			isMap = true
+			p.pos++
+
+			// bare keys like [name:1] are strings in Groovy.
+			if c, ok := item.(Custom); ok && !paren {
+				item = c.String()
+			}
+
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, MapEntry{Key: item, Value: value})
+		} else {
+			if isMap || paren {
+				return nil, p.errorf("expected ':' in a map")
+			}
+			list = append(list, item)
+		}
+
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			if isMap {
+				return m, nil
+			}
+			return list, nil
+		default:
+			if p.eof() {
+				return nil, p.errorf("unclosed list")
+			}
+			return nil, p.errorf("unexpected %q in a list", p.peek())
+		}
+	}
+}
+
+// parseItem reads a list item or map key, which
+// may be wrapped in parentheses like (T.label).
+func (p *parser) parseItem() (interface{}, bool, error) {
+	p.skipSpace()
+
+	if p.peek() != '(' {
+		v, err := p.parseValue()
+		return v, false, err
+	}
+
+	p.pos++
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, true, err
+	}
+
+	return v, true, p.expect(')')
+}
+
+func (p *parser) parseString() (string, error) {
+	var (
+		quoteChar = p.peek()
+		buf       bytes.Buffer
+	)
+
+	p.pos++
+
+	for !p.eof() {
+		c := p.peek()
+		p.pos++
+
+		switch c {
+		case quoteChar:
+			return buf.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", p.errorf("unterminated string")
+			}
+			e := p.peek()
+			p.pos++
+			switch e {
+			case 'n':
+				buf.WriteByte('\n')
+			case 'r':
+				buf.WriteByte('\r')
+			case 't':
+				buf.WriteByte('\t')
+			case '\\', '"', '\'', '$':
+				buf.WriteByte(e)
+			default:
+				buf.WriteByte('\\')
+				buf.WriteByte(e)
+			}
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) parseNumber() (Number, error) {
+	start := p.pos
+
+	if p.peek() == '-' {
+		p.pos++
+	}
+
+	digits := p.pos
+	for !p.eof() && isNumberByte(p.peek()) {
+		p.pos++
+
+		// an exponent may be signed like 1e-5.
+		if e := p.src[p.pos-1]; (e == 'e' || e == 'E') && (p.peek() == '-' || p.peek() == '+') {
+			p.pos++
+		}
+	}
+
+	if p.pos == digits {
+		return "", p.errorf("expected a number")
+	}
+
+	return Number(p.src[start:p.pos]), nil
+}
+
+// isNumberByte accepts digits, a decimal point, exponents
+// and the Groovy type suffixes such as L, d and f.
+func isNumberByte(c byte) bool {
+	switch {
+	case c >= '0' && c <= '9', c == '.':
+		return true
+	}
+
+	return strings.IndexByte("eElLdDfFgGiI", c) >= 0
+}
@@ -0,0 +1,353 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/column"
+	"github.com/northwesternmutual/grammes/query/computer"
+	"github.com/northwesternmutual/grammes/query/consumer"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/dt"
+	"github.com/northwesternmutual/grammes/query/geoshape"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/merge"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/operator"
+	"github.com/northwesternmutual/grammes/query/order"
+	"github.com/northwesternmutual/grammes/query/pick"
+	"github.com/northwesternmutual/grammes/query/pop"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/scope"
+	"github.com/northwesternmutual/grammes/query/strategy"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/withoptions"
+)
+
+// builtQueries returns a traversal for every step builder
+// in this package and the graph package.
+func builtQueries() []String {
+	var (
+		g  = NewTraversal
+		__ = func() String { return NewCustomTraversal("__") }
+	)
+
+	return []String{
+		g(),
+		g().V(),
+		g().V(1, 2),
+		g().E(),
+		g().AddV("person"),
+		g().AddV("person").Property("name", "damien").Property(cardinality.List, "nick", "d"),
+		g().AddV("person").Property("quote", "say \"hi\""),
+		g().AddE("knows").From(g().V(1)).To(g().V(2)),
+		g().AddE("knows").ToVId(2),
+		g().V().Aggregate("x").Cap("x", "y"),
+		g().V().And(__().Out("knows"), __().Has("age")),
+		g().V().As("a", "b").Select("a", "b").By("name"),
+		g().V().Barrier().Barrier(consumer.NormSack),
+		g().V().Both("knows").BothE().BothV(),
+		g().V().Choose(__().Out(), __().In(), __().Both()),
+		g().V().Coalesce(__().Out(), __().In()),
+		g().V().Coin(0.5),
+		g().V().Constant("1"),
+		g().V().Count().Count(scope.Local),
+		g().V().CyclicPath().SimplePath(),
+		g().V().Dedup().Dedup(scope.Local, "a"),
+		g().V().Drop(),
+		g().V().Repeat(__().Out()).Emit(),
+		g().V().Explain(),
+		g().V().Fold().Unfold(),
+		g().V().Group().By(token.Label).GroupCount("x"),
+		g().V().Has("name", "damien").Has("age", predicate.GreaterThan(20)),
+		g().V().Has("age", predicate.Within(1, "two")),
+		g().V().HasID(1, "2").HasKey("k", "l").HasLabel("a", "b").HasNot("c").HasValue("v"),
+		g().V().ID().Identity().Key().Label().Value(),
+		g().V().In("a").InE("b").InV().OutV().OtherV(),
+		g().Inject("x"),
+		g().V().Values("age").Is(predicate.LessThanOrEqual(10)),
+		g().V().Limit(1).Limit(scope.Local, 2),
+		g().V().Local(__().Out().Limit(2)),
+		g().V().Repeat(__().Out()).Until(__().Loops().Is(3)),
+		g().V().Match(__().As("a").Out().As("b")),
+		g().V().Math("_ + 1"),
+		g().V().Values("age").Max().Mean(scope.Local).Min().Sum(scope.Global),
+		g().V().Not(__().Out()),
+		g().V().Choose(__().Values("age")).Option("a").Option("b", "c"),
+		g().MergeV(map[interface{}]interface{}{token.Label: "person", "name": "damien"}).
+			MergeOption(merge.OnCreate, map[string]interface{}{"age": 24}).
+			MergeOption(merge.OnMatch, map[string]interface{}{}),
+		g().WithStrategies(strategy.Partition().PartitionKey("_p").ReadPartitions("a", "b").Create(), strategy.ReadOnly()).
+			WithoutStrategies(strategy.LazyBarrierStrategy).V(),
+		g().V().SideEffect(__().Property("seen", true)).Map(__().Values("name")).FlatMap(__().OutE()).
+			Filter(__().Out("knows")).ElementMap("name").Index().With(withoptions.Indexer, withoptions.Map),
+		g().V().Properties().Element().Fail("oops").None(),
+		g().Call("tinker.search", map[string]interface{}{"search": "vada"}).Next(2),
+		g().V().HasNext(),
+		g().V().Drop().Iterate(),
+		g().V().Values("name").AsString().ToUpper().ToLower(scope.Local).Trim().LTrim().RTrim().Length().
+			Concat("-", __().Values("last")).Split(scope.Local, ",").Substring(1, 3).Replace("a", "b").Reverse(),
+		g().V().Format("%{name}").AsDate().DateAdd(dt.Day, 7).DateDiff(__().Values("start")),
+		g().V().Values("nicks").Fold().Combine([]interface{}{"a", 1}).Merge([]string{"b"}).Product(__().Values("x").Fold()).
+			Intersect([]interface{}{}).Difference([]interface{}{"c"}).Disjunct([]interface{}{"d"}).Conjoin(","),
+		g().V().Has("age", predicate.Between(1, 5).Or(predicate.Not(predicate.Outside(10, 20)))).
+			Has("name", predicate.Containing("am").And(predicate.NotRegex("^x"))).HasID(predicate.Without(1, 2)).
+			Values("age").Is(predicate.GreaterThan(1).Negate()),
+		g().AddV("place").Property("loc", geoshape.NewPoint(44.9, -93.2)).Property("path", geoshape.NewLine(geoshape.Coordinate{Latitude: 1, Longitude: 2})),
+		g().V().Has("loc", predicate.GeoWithin(geoshape.NewCircle(44.9, -93.2, 10))).Has("area", predicate.GeoDisjoint(geoshape.NewBox(1, 2, 3, 4))),
+		g().V().Order().By("age", order.Desc).By(token.ID, order.Shuffle).Values("name"),
+		g().V().Choose(__().Values("age")).Option(1, __().Out()).Option(pick.None, __().Identity()),
+		g().V().Map(NewLambda("it.get().value('name')")).Filter(NewJavaLambda("x -> x.get().label() == \"a\"")).
+			Sack(NewLambda("a, b -> a + b")).Order().By(NewLambda("{ a, b -> a <=> b }")),
+		g().WithComputer(computer.Compute(computer.SparkGraphComputer).Workers(2).Persist(computer.Edges).Result(computer.New)).
+			V().ConnectedComponent().With(computer.ConnectedComponentEdges, __().OutE("knows")),
+		NewSource().WithSideEffect("x", 0, operator.Sum).WithSack(1, NewLambda("it.clone()"), operator.Sum).
+			WithBulk(false).WithPath().With("evaluationTimeout", 500).V().Sack(),
+		NewSource().With("Neptune#useDFE").Inject(1, "a"),
+		g().WithComputer().V().ShortestPath().With(computer.ShortestPathDistance, "weight").With(computer.ShortestPathIncludeEdges, true),
+		g().MergeE(map[interface{}]interface{}{token.Label: "knows", direction.Out: 1, direction.In: 2}),
+		g().V().Optional(__().Out()).Or(__().In(), __().Out()),
+		g().V().Order().Order(scope.Local),
+		g().V().Out("a", "b").OutE("c"),
+		g().V().PageRank().PageRank(0.85),
+		g().V().Path().PeerPressure(),
+		g().V().Profile().Profile("x"),
+		g().V().Program("x"),
+		g().V().Project("a", "b").By(__().Out().Count()),
+		g().V().Properties("a", "b").PropertyMap("c").ValueMap().ValueMap(true, "d"),
+		g().V().Range(0, 10).Range(scope.Local, 1, 2),
+		g().V().Sack(operator.Sum).WithSack(1.5),
+		g().V().Sample(2).Sample(scope.Local, 3),
+		g().V().Select(column.Keys).Select(pop.First, "a"),
+		g().V().Skip(2).Skip(scope.Local, 3),
+		g().V().Store("x").SubGraph("sg"),
+		g().V().Tail(1).Tail(scope.Local, 2),
+		g().V().TimeLimit(10),
+		g().V().ToV(direction.In),
+		g().V().Tree("t"),
+		g().V().Union(__().Out(), __().In()),
+		g().V().Where(__().Out()),
+	}
+}
+
+func builtGraphQueries() []graph.String {
+	return []graph.String{
+		graph.NewGraph().OpenManagement().MakePropertyKey("name", datatype.String, cardinality.Single).Make(),
+		graph.NewGraph().OpenManagement().MakeEdgeLabel("knows").Multiplicity(multiplicity.Multi).Make(),
+		graph.NewGraph().OpenManagement().MakeVertexLabel("person").Make(),
+		graph.NewGraph().OpenManagement().Commit(),
+		graph.NewGraph().AddVertex(token.Label, "person", "name", "damien"),
+	}
+}
+
+func TestParse(t *testing.T) {
+	Convey("Given a traversal string", t, func() {
+		Convey("When 'Parse' is called with a lone source", func() {
+			result, err := Parse("g")
+			Convey("Then the source should be 'g' with no steps", func() {
+				So(err, ShouldBeNil)
+				So(result.Source, ShouldEqual, "g")
+				So(result.Steps, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When 'Parse' is called with every kind of argument", func() {
+			result, err := Parse(`g.V(1L).has('name', "da\"mien").has(T.id, P.gt(-2.5d)).valueMap(true).where(out("knows").count())`)
+			Convey("Then each argument should be typed", func() {
+				So(err, ShouldBeNil)
+				So(result.Source, ShouldEqual, "g")
+				So(result.Steps, ShouldHaveLength, 5)
+				So(result.Steps[0].Args, ShouldResemble, []interface{}{Number("1L")})
+				So(result.Steps[1].Args, ShouldResemble, []interface{}{"name", "da\"mien"})
+				So(result.Steps[2].Args[0], ShouldEqual, Custom("T.id"))
+				So(result.Steps[2].Args[1], ShouldResemble, Script{
+					Source: "P",
+					Steps:  []Step{NewStep("gt", Number("-2.5d"))},
+				})
+				So(result.Steps[3].Args, ShouldResemble, []interface{}{true})
+				So(result.Steps[4].Args[0], ShouldResemble, Script{
+					Steps: []Step{NewStep("out", "knows"), NewStep("count")},
+				})
+			})
+		})
+
+		Convey("When 'Parse' is called with whitespace between steps", func() {
+			result, err := Parse("g.V()\n  .out( \"a\" , 'b' )\n  .count()")
+			Convey("Then the whitespace should be dropped when rendering", func() {
+				So(err, ShouldBeNil)
+				So(result.String(), ShouldEqual, "g.V().out(\"a\",\"b\").count()")
+			})
+		})
+
+		Convey("When 'Parse' is called with an anonymous traversal", func() {
+			result, err := Parse("__.out().in()")
+			Convey("Then the source should be '__'", func() {
+				So(err, ShouldBeNil)
+				So(result.Source, ShouldEqual, "__")
+				So(result.String(), ShouldEqual, "__.out().in()")
+			})
+		})
+
+		Convey("When 'Parse' is called with lists and maps", func() {
+			result, err := Parse("g.inject([1,\"a\"],[],[:]).mergeV([name:\"damien\",(T.label):\"person\",\"age\":24])")
+			Convey("Then they should be typed and bare keys should become strings", func() {
+				So(err, ShouldBeNil)
+				So(result.Steps[0].Args, ShouldResemble, []interface{}{List{Number("1"), "a"}, List{}, Map{}})
+				So(result.Steps[1].Args[0], ShouldResemble, Map{
+					{Key: "name", Value: "damien"},
+					{Key: Custom("T.label"), Value: "person"},
+					{Key: "age", Value: Number("24")},
+				})
+				So(result.String(), ShouldEqual, "g.inject([1,\"a\"],[],[:]).mergeV([\"name\":\"damien\",(T.label):\"person\",\"age\":24])")
+			})
+		})
+
+		Convey("When 'Parse' is called with dollar signs in strings", func() {
+			result, err := Parse(`g.V().has('name','a$b').has("x","\${x}").has("y","${y}")`)
+			Convey("Then they should be read as text and rendered escaped", func() {
+				So(err, ShouldBeNil)
+				So(result.Steps[1].Args, ShouldResemble, []interface{}{"name", "a$b"})
+				So(result.Steps[2].Args, ShouldResemble, []interface{}{"x", "${x}"})
+				So(result.String(), ShouldEqual, `g.V().has("name","a\$b").has("x","\${x}").has("y","\${y}")`)
+			})
+		})
+
+		Convey("When 'Parse' is called with exponent numbers", func() {
+			result, err := Parse("g.V().has(\"w\",P.gt(1e-5)).coin(2.5E+3d)")
+			Convey("Then they should be kept as written", func() {
+				So(err, ShouldBeNil)
+				So(result.Steps[2].Args, ShouldResemble, []interface{}{Number("2.5E+3d")})
+				So(result.String(), ShouldEqual, "g.V().has(\"w\",P.gt(1e-5)).coin(2.5E+3d)")
+			})
+		})
+
+		Convey("When 'Parse' is called with trailing closures", func() {
+			result, err := Parse("g.V().map{ it.get() }.filter (){ true }.sack(sum){a, b -> a}.count()")
+			Convey("Then each closure should be the last argument of its step", func() {
+				So(err, ShouldBeNil)
+				So(result.Steps, ShouldHaveLength, 5)
+				So(result.Steps[1].Args, ShouldResemble, []interface{}{NewLambda("{ it.get() }")})
+				So(result.Steps[2].Args, ShouldResemble, []interface{}{NewLambda("{ true }")})
+				So(result.Steps[3].Args, ShouldResemble, []interface{}{Custom("sum"), NewLambda("{a, b -> a}")})
+				So(result.Steps[4].Name, ShouldEqual, "count")
+			})
+		})
+
+		Convey("When 'Parse' is called with invalid scripts", func() {
+			for _, s := range []string{
+				"",
+				"g.V(",
+				"g.V().has(\"a)",
+				"g.V().has(\"a\" \"b\")",
+				"g.V().out",
+				"g.V().map{ it.get()",
+				"g.V();g.E()",
+				"g.V().has(,)",
+				"g.inject([1,2)",
+				"g.inject([1,a:2])",
+				"g.inject([a:1,2])",
+				"1",
+			} {
+				_, err := Parse(s)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	Convey("Given traversals made by every step builder", t, func() {
+		Convey("When each one is parsed and rendered again", func() {
+			Convey("Then the result should equal the original", func() {
+				for _, q := range builtQueries() {
+					s, err := Parse(q.String())
+					So(err, ShouldBeNil)
+					So(s.String(), ShouldEqual, q.String())
+					So(s.Traversal().String(), ShouldEqual, q.String())
+				}
+			})
+		})
+
+		Convey("When each graph query is parsed and rendered again", func() {
+			Convey("Then the result should equal the original", func() {
+				for _, q := range builtGraphQueries() {
+					s, err := Parse(q.String())
+					So(err, ShouldBeNil)
+					So(s.Source, ShouldEqual, "graph")
+					So(s.String(), ShouldEqual, q.String())
+				}
+			})
+		})
+
+		Convey("When a builder that adds whitespace is parsed and rendered again", func() {
+			q := NewTraversal().V().Has("age", predicate.Inside(1, 5)).ToE(direction.Out, "knows")
+			s, err := Parse(q.String())
+			Convey("Then the result should only lose the whitespace", func() {
+				So(err, ShouldBeNil)
+				So(s.String(), ShouldEqual, "g.V().has(\"age\",inside(1,5)).toE(OUT,\"knows\")")
+			})
+		})
+	})
+}
+
+func TestScriptInsert(t *testing.T) {
+	Convey("Given a parsed traversal", t, func() {
+		s, _ := Parse("g.V().hasLabel(\"person\").values(\"name\")")
+		Convey("When 'Insert' is called after the V step", func() {
+			s.Insert(s.Index("V")+1, NewStep("has", "tenant", Custom("x")))
+			Convey("Then the step should be rendered after V()", func() {
+				So(s.String(), ShouldEqual, "g.V().has(\"tenant\",x).hasLabel(\"person\").values(\"name\")")
+			})
+		})
+
+		Convey("When 'Insert' is called at the end", func() {
+			s.Insert(len(s.Steps), NewStep("limit", 1), NewStep("count"))
+			Convey("Then the steps should be appended", func() {
+				So(s.String(), ShouldEqual, "g.V().hasLabel(\"person\").values(\"name\").limit(1).count()")
+			})
+		})
+
+		Convey("When 'Insert' is called before the first step", func() {
+			s.Insert(-2, NewStep("withBulk", false))
+			Convey("Then the step should be inserted at the start", func() {
+				So(s.String(), ShouldEqual, "g.withBulk(false).V().hasLabel(\"person\").values(\"name\")")
+			})
+		})
+
+		Convey("When 'Insert' is called past the last step", func() {
+			s.Insert(len(s.Steps)+5, NewStep("count"))
+			Convey("Then the step should be appended", func() {
+				So(s.String(), ShouldEqual, "g.V().hasLabel(\"person\").values(\"name\").count()")
+			})
+		})
+
+		Convey("When 'Index' is called with a missing step", func() {
+			Convey("Then -1 should be returned", func() {
+				So(s.Index("out"), ShouldEqual, -1)
+			})
+		})
+	})
+}
@@ -43,5 +43,12 @@ func TestProperties(t *testing.T) {
 				So(result.String(), ShouldEqual, "g.properties(\"test1\",\"test2\",\"test3\")")
 			})
 		})
+
+		Convey("When 'Properties' is called on edges with keys", func() {
+			result := g.E().HasLabel("knows").Properties("weight", "since")
+			Convey("Then result should equal 'g.E().hasLabel('knows').properties('weight','since')'", func() {
+				So(result.String(), ShouldEqual, "g.E().hasLabel(\"knows\").properties(\"weight\",\"since\")")
+			})
+		})
 	})
 }
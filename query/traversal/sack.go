@@ -20,19 +20,18 @@
 
 package traversal
 
-import "github.com/northwesternmutual/grammes/query/operator"
-
 // http://tinkerpop.apache.org/docs/current/reference/#sack-step
 
 // Sack (sideEffect or map) is used to read and write sacks.
 // Signatures:
 // Sack()
-// Sack(string (Bifunction))
-func (g String) Sack(operator ...operator.Operator) String {
+// Sack(operator.Operator (Bifunction))
+// Sack(Lambda (Bifunction))
+func (g String) Sack(operatorOrLambda ...interface{}) String {
 	var i interface{}
 
-	if len(operator) == 1 {
-		i = operator[0]
+	if len(operatorOrLambda) == 1 {
+		i = operatorOrLambda[0]
 	}
 
 	if i != nil {
@@ -0,0 +1,55 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/computer"
+)
+
+func TestShortestPath(t *testing.T) {
+	Convey("Given a ) String { that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+		Convey("When 'ShortestPath' is called", func() {
+			result := g.ShortestPath()
+			Convey("Then result should equal 'g.shortestPath()'", func() {
+				So(result.String(), ShouldEqual, "g.shortestPath()")
+			})
+		})
+
+		Convey("When 'ShortestPath' is configured with 'With'", func() {
+			result := g.WithComputer().V().ShortestPath().
+				With(computer.ShortestPathTarget, NewCustomTraversal("__").Has("name", "peter")).
+				With(computer.ShortestPathMaxDistance, 3)
+			Convey("Then the options should be rendered", func() {
+				So(result.String(), ShouldEqual, "g.withComputer().V().shortestPath().with(ShortestPath.target,__.has(\"name\",\"peter\")).with(ShortestPath.maxDistance,3)")
+			})
+			Convey("Then the traversal should be valid", func() {
+				script, err := Parse(result.String())
+				So(err, ShouldBeNil)
+				So(script.Validate(), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"github.com/northwesternmutual/grammes/query/computer"
+	"github.com/northwesternmutual/grammes/query/strategy"
+)
+
+// https://tinkerpop.apache.org/docs/current/reference/#start-steps
+
+// Source configures the traversal source before a
+// traversal is started from it with V(), E(), AddV(),
+// AddE(), Inject(), MergeV(), MergeE() or Call().
+//
+//	traversal.NewSource().WithSack(1.0, operator.Sum).WithBulk(false).V()
+type Source struct {
+	g String
+}
+
+// NewSource returns a Source that
+// configures the "g" traversal source.
+func NewSource() Source {
+	return Source{g: NewTraversal()}
+}
+
+// NewCustomSource returns a Source that configures
+// another traversal source such as graph.traversal().
+func NewCustomSource(str string) Source {
+	return Source{g: NewCustomTraversal(str)}
+}
+
+func (s Source) String() string {
+	return s.g.String()
+}
+
+// WithSideEffect adds a side effect that every
+// traversal from the source can read with the key.
+// Signatures:
+// WithSideEffect(string, interface{})
+// WithSideEffect(string, interface{}, interface{} (BinaryOperator))
+func (s Source) WithSideEffect(key string, value interface{}, reducer ...interface{}) Source {
+	params := []interface{}{key, value}
+	if len(reducer) > 0 {
+		params = append(params, reducer[0])
+	}
+
+	s.g.AddStep("withSideEffect", params...)
+
+	return s
+}
+
+// WithSack gives every traverser a sack with the initial value.
+// The operators are an optional split operator, such as a Lambda,
+// which copies the sack when a traverser splits, and an optional
+// merge operator, such as operator.Sum, which joins the sacks of
+// merged traversers.
+// Signatures:
+// WithSack(interface{})
+// WithSack(interface{}, operator.Operator)
+// WithSack(interface{}, Lambda, operator.Operator)
+func (s Source) WithSack(initial interface{}, operators ...interface{}) Source {
+	s.g.AddStep("withSack", append([]interface{}{initial}, operators...)...)
+
+	return s
+}
+
+// WithBulk determines whether traversers are bulked.
+// Signatures:
+// WithBulk(bool)
+func (s Source) WithBulk(bulk bool) Source {
+	s.g.AddStep("withBulk", bulk)
+
+	return s
+}
+
+// WithPath makes every traverser keep its path.
+// Signatures:
+// WithPath()
+func (s Source) WithPath() Source {
+	s.g.AddStep("withPath")
+
+	return s
+}
+
+// With sets a configuration key of the source, such as the
+// keys in the sourceoption package. The value defaults to true.
+// Signatures:
+// With(string)
+// With(string, interface{})
+func (s Source) With(key string, value ...interface{}) Source {
+	if len(value) > 0 {
+		s.g.AddStep("with", key, value[0])
+	} else {
+		s.g.AddStep("with", key)
+	}
+
+	return s
+}
+
+// WithStrategies adds strategies to the source.
+// Signatures:
+// WithStrategies(...strategy.Strategy)
+func (s Source) WithStrategies(strategies ...strategy.Strategy) Source {
+	s.g = s.g.WithStrategies(strategies...)
+
+	return s
+}
+
+// WithoutStrategies removes strategies from the source.
+// Signatures:
+// WithoutStrategies(...strategy.Name)
+func (s Source) WithoutStrategies(names ...strategy.Name) Source {
+	s.g = s.g.WithoutStrategies(names...)
+
+	return s
+}
+
+// WithComputer runs the traversals from the source as OLAP jobs.
+// Signatures:
+// WithComputer()
+// WithComputer(computer.Computer)
+func (s Source) WithComputer(c ...computer.Computer) Source {
+	s.g = s.g.WithComputer(c...)
+
+	return s
+}
+
+// V starts a traversal from the vertices.
+func (s Source) V(ids ...int) String {
+	return s.g.V(ids...)
+}
+
+// E starts a traversal from the edges.
+func (s Source) E() String {
+	return s.g.E()
+}
+
+// AddV starts a traversal by adding a vertex.
+func (s Source) AddV(params ...interface{}) String {
+	return s.g.AddV(params...)
+}
+
+// AddE starts a traversal by adding an edge.
+func (s Source) AddE(param interface{}) String {
+	return s.g.AddE(param)
+}
+
+// Inject starts a traversal from the objects.
+func (s Source) Inject(objs ...interface{}) String {
+	s.g.AddStep("inject", objs...)
+
+	return s.g
+}
+
+// MergeV starts a traversal by merging a vertex.
+func (s Source) MergeV(params ...interface{}) String {
+	return s.g.MergeV(params...)
+}
+
+// MergeE starts a traversal by merging an edge.
+func (s Source) MergeE(params ...interface{}) String {
+	return s.g.MergeE(params...)
+}
+
+// Call starts a traversal from a provider's service.
+func (s Source) Call(params ...interface{}) String {
+	return s.g.Call(params...)
+}
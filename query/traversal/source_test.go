@@ -0,0 +1,113 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/computer"
+	"github.com/northwesternmutual/grammes/query/operator"
+	"github.com/northwesternmutual/grammes/query/sourceoption"
+	"github.com/northwesternmutual/grammes/query/strategy"
+)
+
+func TestSource(t *testing.T) {
+	Convey("Given a Source that configures the traversal source", t, func() {
+		s := NewSource()
+		Convey("When no options are used", func() {
+			Convey("Then the source should be 'g'", func() {
+				So(s.String(), ShouldEqual, "g")
+				So(s.V().String(), ShouldEqual, "g.V()")
+			})
+		})
+
+		Convey("When 'WithSideEffect' is called", func() {
+			result := s.WithSideEffect("x", 0).WithSideEffect("y", 1, operator.Sum).V()
+			Convey("Then the side effects should come before V()", func() {
+				So(result.String(), ShouldEqual, "g.withSideEffect(\"x\",0).withSideEffect(\"y\",1,sum).V()")
+			})
+		})
+
+		Convey("When 'WithSack' is called with split and merge operators", func() {
+			result := s.WithSack(1.0, NewLambda("it.clone()"), operator.Sum).E()
+			Convey("Then the operators should be rendered", func() {
+				So(result.String(), ShouldEqual, "g.withSack(1,{it.clone()},sum).E()")
+			})
+		})
+
+		Convey("When 'WithSack' is called with a merge operator", func() {
+			result := s.WithSack(1.5, operator.Mult).V(1)
+			Convey("Then the operator should be rendered", func() {
+				So(result.String(), ShouldEqual, "g.withSack(1.5,mult).V(1)")
+			})
+		})
+
+		Convey("When 'WithBulk' and 'WithPath' are called", func() {
+			result := s.WithBulk(false).WithPath().AddV("person")
+			Convey("Then they should come before addV()", func() {
+				So(result.String(), ShouldEqual, "g.withBulk(false).withPath().addV(\"person\")")
+			})
+		})
+
+		Convey("When 'With' is called", func() {
+			result := s.With(sourceoption.NeptuneEnableResultCacheWithTTL, 60).With(sourceoption.NeptuneUseDFE).Inject(1, "a")
+			Convey("Then the options should come before inject()", func() {
+				So(result.String(), ShouldEqual, "g.with(\"Neptune#enableResultCacheWithTTL\",60).with(\"Neptune#useDFE\").inject(1,\"a\")")
+			})
+		})
+
+		Convey("When strategies and a computer are used", func() {
+			result := s.WithStrategies(strategy.ReadOnly()).WithoutStrategies(strategy.Name("LazyBarrierStrategy")).
+				WithComputer(computer.Compute(computer.FulgoraGraphComputer)).V().PageRank()
+			Convey("Then they should come before V()", func() {
+				So(result.String(), ShouldEqual, "g.withStrategies(ReadOnlyStrategy.instance()).withoutStrategies(LazyBarrierStrategy).withComputer(Computer.compute(FulgoraGraphComputer)).V().pageRank()")
+			})
+		})
+
+		Convey("When two traversals are started from the same Source", func() {
+			base := s.WithBulk(false)
+			a := base.WithPath().V()
+			b := base.With(sourceoption.EvaluationTimeout, 500).E()
+			Convey("Then they should not share options", func() {
+				So(a.String(), ShouldEqual, "g.withBulk(false).withPath().V()")
+				So(b.String(), ShouldEqual, "g.withBulk(false).with(\"evaluationTimeout\",500).E()")
+			})
+		})
+
+		Convey("When the merge steps and call start a traversal", func() {
+			Convey("Then they should be rendered after the options", func() {
+				So(s.WithPath().MergeV(map[string]interface{}{"name": "a"}).String(), ShouldEqual, "g.withPath().mergeV([\"name\":\"a\"])")
+				So(s.WithPath().MergeE(map[string]interface{}{"weight": 1}).String(), ShouldEqual, "g.withPath().mergeE([\"weight\":1])")
+				So(s.WithPath().AddE("knows").String(), ShouldEqual, "g.withPath().addE(\"knows\")")
+				So(s.WithPath().Call().String(), ShouldEqual, "g.withPath().call()")
+			})
+		})
+
+		Convey("When a custom source is used", func() {
+			result := NewCustomSource("graph.traversal()").WithBulk(true).V()
+			Convey("Then the options should follow the custom source", func() {
+				So(result.String(), ShouldEqual, "graph.traversal().withBulk(true).V()")
+			})
+		})
+	})
+}
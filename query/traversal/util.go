@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/northwesternmutual/grammes/query/literal"
 )
 
 // fmtStr is used because it prevents from
@@ -71,8 +73,12 @@ func (g String) append(str string) String {
 
 // AddStep will add a new step to the traversal string
 // using a list of parameters.
+//
+// Each call writes into a buffer owned by this copy of the
+// traversal, so copies forked from a common base never
+// write into each other's buffers.
 func (g *String) AddStep(step string, params ...interface{}) {
-	g.buffer.Reset()
+	g.buffer = bytes.NewBufferString("")
 
 	g.buffer.WriteString("." + step + "(")
 
@@ -87,7 +93,13 @@ func (g *String) AddStep(step string, params ...interface{}) {
 		case []byte:
 			g.buffer.Write(t)
 		case string:
-			g.buffer.WriteString("\"" + t + "\"")
+			g.buffer.WriteString(literal.Quote(t))
+		case map[string]interface{}, map[interface{}]interface{}:
+			g.buffer.WriteString(toMap(t).String())
+		case []interface{}:
+			g.buffer.WriteString(List(t).String())
+		case []string:
+			g.buffer.WriteString(toList(t).String())
 		default:
 			g.buffer.WriteString(fmt.Sprintf("%v", t))
 		}
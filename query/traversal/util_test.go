@@ -76,6 +76,18 @@ func TestAddStep(t *testing.T) {
 				So(g.String(), ShouldEqual, "g.test(true)")
 			})
 		})
+
+		Convey("When AddStep is called with strings that need escaping", func() {
+			g.AddStep("has", "name", `a"b`)
+			g.AddStep("has", "name", "${x}")
+			Convey("Then they should be escaped like strings inside a map", func() {
+				So(g.String(), ShouldEqual, `g.has("name","a\"b").has("name","\${x}")`)
+
+				m := NewTraversal()
+				m.AddStep("inject", map[string]interface{}{"name": "${x}"})
+				So(m.String(), ShouldEqual, `g.inject(["name":"\${x}"])`)
+			})
+		})
 	})
 
 }
@@ -0,0 +1,548 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+// Validate checks the steps of the traversal against TinkerPop's
+// grammar so mistakes are caught before the query is sent to the
+// server, where they would only surface as a script evaluation error.
+func (g String) Validate() error {
+	s, err := Parse(g.String())
+	if err != nil {
+		return gremerror.NewValidationError("", 0, err.Error())
+	}
+
+	return s.Validate()
+}
+
+// Validate checks the steps of the script against TinkerPop's
+// grammar. This checks that each step is known, receives a valid
+// number and kind of arguments and that modulators such as by()
+// and option() follow a step they can modulate.
+//
+// Scripts that do not start from a traversal source, such as
+// the management scripts from the graph package, are not checked.
+func (s Script) Validate() error {
+	switch s.Source {
+	case "g":
+		return validateSteps(s.Steps, true)
+	case "", "__":
+		return validateSteps(s.Steps, false)
+	default:
+		return nil
+	}
+}
+
+// argKind is the kind of value an argument may be.
+type argKind int
+
+const (
+	kindString argKind = 1 << iota
+	kindNumber
+	kindBool
+	kindIdent
+	kindTraversal
+	kindPredicate
+	kindValue
+
+	kindObject = kindString | kindNumber | kindBool | kindIdent | kindValue
+	kindAny    = kindObject | kindTraversal | kindPredicate
+)
+
+// stepRule describes the arguments a step accepts.
+type stepRule struct {
+	// min and max are the number of arguments the
+	// step takes. A max of -1 takes any number.
+	min, max int
+	// args is the kind of each argument. The last kind is
+	// used for any arguments past the end of the slice.
+	args []argKind
+}
+
+func rule(min, max int, args ...argKind) stepRule {
+	return stepRule{min: min, max: max, args: args}
+}
+
+// steps are all of the steps in TinkerPop's grammar.
+var steps = map[string]stepRule{
+	// source steps.
+	"withBulk":          rule(1, 1, kindBool|kindIdent),
+	"withComputer":      rule(0, 1, kindAny),
+	"withPath":          rule(0, 0),
+	"withSack":          rule(1, 3, kindAny),
+	"withSideEffect":    rule(2, 3, kindAny),
+	"withStrategies":    rule(1, -1, kindAny),
+	"withoutStrategies": rule(1, -1, kindIdent|kindValue),
+
+	// start steps.
+	"V":      rule(0, -1, kindObject|kindTraversal),
+	"E":      rule(0, -1, kindObject|kindTraversal),
+	"addV":   rule(0, 1, kindString|kindIdent|kindTraversal),
+	"addE":   rule(1, 1, kindString|kindIdent|kindTraversal),
+	"inject": rule(0, -1, kindObject),
+	"mergeV": rule(0, 1, kindObject|kindTraversal),
+	"mergeE": rule(0, 1, kindObject|kindTraversal),
+	"call":   rule(0, 3, kindAny),
+	"io":     rule(1, 1, kindString|kindIdent),
+
+	// modulators.
+	"as":     rule(1, -1, kindString|kindIdent),
+	"by":     rule(0, 2, kindAny),
+	"emit":   rule(0, 1, kindTraversal|kindPredicate|kindIdent|kindValue),
+	"from":   rule(1, 1, kindString|kindIdent|kindTraversal|kindValue),
+	"option": rule(1, 2, kindAny),
+	"read":   rule(0, 0),
+	"times":  rule(1, 1, kindNumber|kindIdent),
+	"to":     rule(1, 2, kindString|kindIdent|kindTraversal|kindValue),
+	"until":  rule(1, 1, kindTraversal|kindPredicate|kindIdent|kindValue),
+	"with":   rule(1, 2, kindAny),
+	"write":  rule(0, 0),
+
+	// the rest of the steps.
+	"aggregate":          rule(1, 2, kindString|kindIdent),
+	"all":                rule(1, 1, kindPredicate|kindIdent),
+	"and":                rule(0, -1, kindTraversal|kindIdent),
+	"any":                rule(1, 1, kindPredicate|kindIdent),
+	"asDate":             rule(0, 0),
+	"asString":           rule(0, 1, kindIdent),
+	"barrier":            rule(0, 1, kindNumber|kindIdent),
+	"both":               rule(0, -1, kindString|kindIdent),
+	"bothE":              rule(0, -1, kindString|kindIdent),
+	"bothV":              rule(0, 0),
+	"branch":             rule(1, 1, kindTraversal|kindIdent|kindValue),
+	"cap":                rule(1, -1, kindString|kindIdent),
+	"choose":             rule(1, 3, kindTraversal|kindPredicate|kindIdent|kindValue),
+	"coalesce":           rule(0, -1, kindTraversal|kindIdent),
+	"coin":               rule(1, 1, kindNumber|kindIdent),
+	"combine":            rule(1, 1, kindObject|kindTraversal),
+	"concat":             rule(0, -1, kindString|kindIdent|kindTraversal),
+	"conjoin":            rule(1, 1, kindString|kindIdent),
+	"connectedComponent": rule(0, 0),
+	"constant":           rule(1, 1, kindObject),
+	"count":              rule(0, 1, kindIdent),
+	"cyclicPath":         rule(0, 0),
+	"dateAdd":            rule(2, 2, kindIdent, kindNumber|kindIdent),
+	"dateDiff":           rule(1, 1, kindObject|kindTraversal),
+	"dedup":              rule(0, -1, kindString|kindIdent),
+	"difference":         rule(1, 1, kindObject|kindTraversal),
+	"disjunct":           rule(1, 1, kindObject|kindTraversal),
+	"drop":               rule(0, 0),
+	"element":            rule(0, 0),
+	"elementMap":         rule(0, -1, kindString|kindIdent),
+	"explain":            rule(0, 0),
+	"fail":               rule(0, 1, kindString|kindIdent),
+	"filter":             rule(1, 1, kindTraversal|kindPredicate|kindIdent|kindValue),
+	"flatMap":            rule(1, 1, kindTraversal|kindIdent|kindValue),
+	"fold":               rule(0, 2, kindObject),
+	"format":             rule(1, 1, kindString|kindIdent),
+	"group":              rule(0, 1, kindString|kindIdent),
+	"groupCount":         rule(0, 1, kindString|kindIdent),
+	"has":                rule(1, 3, kindString|kindIdent, kindObject|kindPredicate|kindTraversal, kindObject|kindPredicate|kindTraversal),
+	"hasId":              rule(1, -1, kindObject|kindPredicate),
+	"hasKey":             rule(1, -1, kindString|kindIdent|kindPredicate),
+	"hasLabel":           rule(1, -1, kindString|kindIdent|kindPredicate),
+	"hasNot":             rule(1, 1, kindString|kindIdent),
+	"hasValue":           rule(1, -1, kindObject|kindPredicate),
+	"id":                 rule(0, 0),
+	"identity":           rule(0, 0),
+	"in":                 rule(0, -1, kindString|kindIdent),
+	"inE":                rule(0, -1, kindString|kindIdent),
+	"inV":                rule(0, 0),
+	"index":              rule(0, 0),
+	"intersect":          rule(1, 1, kindObject|kindTraversal),
+	"is":                 rule(1, 1, kindObject|kindPredicate),
+	"key":                rule(0, 0),
+	"label":              rule(0, 0),
+	"length":             rule(0, 1, kindIdent),
+	"limit":              rule(1, 2, kindNumber|kindIdent, kindNumber|kindIdent),
+	"local":              rule(1, 1, kindTraversal|kindIdent),
+	"loops":              rule(0, 1, kindString|kindIdent),
+	"lTrim":              rule(0, 1, kindIdent),
+	"map":                rule(1, 1, kindTraversal|kindIdent|kindValue),
+	"match":              rule(1, -1, kindTraversal|kindIdent),
+	"math":               rule(1, 1, kindString|kindIdent),
+	"max":                rule(0, 1, kindIdent),
+	"mean":               rule(0, 1, kindIdent),
+	"merge":              rule(1, 1, kindObject|kindTraversal),
+	"min":                rule(0, 1, kindIdent),
+	"none":               rule(0, 1, kindPredicate|kindIdent),
+	"not":                rule(1, 1, kindTraversal|kindIdent),
+	"optional":           rule(1, 1, kindTraversal|kindIdent),
+	"or":                 rule(0, -1, kindTraversal|kindIdent),
+	"order":              rule(0, 1, kindIdent),
+	"otherV":             rule(0, 0),
+	"out":                rule(0, -1, kindString|kindIdent),
+	"outE":               rule(0, -1, kindString|kindIdent),
+	"outV":               rule(0, 0),
+	"pageRank":           rule(0, 1, kindNumber|kindIdent),
+	"path":               rule(0, 0),
+	"peerPressure":       rule(0, 0),
+	"product":            rule(1, 1, kindObject|kindTraversal),
+	"profile":            rule(0, 1, kindString|kindIdent),
+	"program":            rule(1, 1, kindAny),
+	"project":            rule(1, -1, kindString|kindIdent),
+	"properties":         rule(0, -1, kindString|kindIdent),
+	"property":           rule(1, -1, kindAny),
+	"propertyMap":        rule(0, -1, kindString|kindIdent),
+	"range":              rule(2, 3, kindNumber|kindIdent),
+	"repeat":             rule(1, 2, kindString|kindIdent|kindTraversal, kindTraversal|kindIdent),
+	"replace":            rule(2, 3, kindString|kindIdent),
+	"reverse":            rule(0, 0),
+	"rTrim":              rule(0, 1, kindIdent),
+	"sack":               rule(0, 1, kindIdent|kindValue),
+	"sample":             rule(1, 2, kindNumber|kindIdent),
+	"select":             rule(1, -1, kindString|kindIdent|kindTraversal),
+	"shortestPath":       rule(0, 0),
+	"sideEffect":         rule(1, 1, kindTraversal|kindIdent|kindValue),
+	"simplePath":         rule(0, 0),
+	"skip":               rule(1, 2, kindNumber|kindIdent),
+	"split":              rule(1, 2, kindString|kindIdent),
+	"store":              rule(1, 1, kindString|kindIdent),
+	"subgraph":           rule(1, 1, kindString|kindIdent),
+	"substring":          rule(1, 3, kindNumber|kindIdent),
+	"sum":                rule(0, 1, kindIdent),
+	"tail":               rule(0, 2, kindNumber|kindIdent),
+	"timeLimit":          rule(1, 1, kindNumber|kindIdent),
+	"toE":                rule(1, -1, kindIdent, kindString|kindIdent),
+	"toLower":            rule(0, 1, kindIdent),
+	"toUpper":            rule(0, 1, kindIdent),
+	"toV":                rule(1, 1, kindIdent),
+	"tree":               rule(0, 1, kindString|kindIdent),
+	"trim":               rule(0, 1, kindIdent),
+	"unfold":             rule(0, 0),
+	"union":              rule(0, -1, kindTraversal|kindIdent),
+	"value":              rule(0, 0),
+	"valueMap":           rule(0, -1, kindBool|kindString|kindIdent),
+	"values":             rule(0, -1, kindString|kindIdent),
+	"where":              rule(1, 2, kindString|kindIdent|kindTraversal|kindPredicate),
+
+	// terminal steps.
+	"hasNext":   rule(0, 0),
+	"iterate":   rule(0, 0),
+	"next":      rule(0, 1, kindNumber|kindIdent),
+	"toBulkSet": rule(0, 0),
+	"toList":    rule(0, 0),
+	"toSet":     rule(0, 0),
+	"tryNext":   rule(0, 0),
+}
+
+var (
+	// sourceSteps may only be used directly on
+	// the traversal source before any other step.
+	sourceSteps = stepSet("withBulk", "withComputer", "withPath", "withSack",
+		"withSideEffect", "withStrategies", "withoutStrategies", "with")
+	// startSteps may begin a traversal from the traversal source.
+	startSteps = stepSet("V", "E", "addV", "addE", "inject", "mergeV",
+		"mergeE", "call", "io", "union")
+	// byHosts are the steps that can be modulated by by().
+	byHosts = stepSet("aggregate", "cyclicPath", "dedup", "format", "group",
+		"groupCount", "math", "order", "pageRank", "path", "peerPressure",
+		"project", "propertyMap", "sack", "sample", "select", "simplePath",
+		"store", "tree", "valueMap", "where")
+	// optionHosts are the steps that can be modulated by option().
+	optionHosts = stepSet("branch", "choose", "mergeV", "mergeE")
+	// fromToHosts are the steps that can be modulated by from() and to().
+	fromToHosts = stepSet("addE", "cyclicPath", "path", "simplePath")
+	// predicates are the names used by P, TextP and the JanusGraph predicates.
+	predicates = stepSet("eq", "neq", "lt", "lte", "gt", "gte", "inside",
+		"outside", "between", "within", "without", "test", "startingWith",
+		"endingWith", "containing", "notStartingWith", "notEndingWith",
+		"notContaining", "regex", "notRegex", "textContains",
+		"textContainsPrefix", "textContainsRegex", "textContainsFuzzy",
+		"textContainsPhrase", "textNotContains", "textNotContainsPrefix",
+		"textNotContainsRegex", "textNotContainsFuzzy", "textNotContainsPhrase",
+		"textPrefix", "textRegex", "textFuzzy", "textNotPrefix", "textNotRegex",
+		"textNotFuzzy", "geoIntersect", "geoInside", "geoWithin", "geoContains",
+		"geoDisjoint")
+	// predicateSources are the classes predicates are called from.
+	predicateSources = stepSet("P", "TextP", "Text", "Geo")
+	// cardinalities may be used as the first argument of property().
+	cardinalities = stepSet("single", "list", "set", "SINGLE", "LIST", "SET")
+)
+
+func stepSet(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[n] = true
+	}
+	return set
+}
+
+// validateSteps checks every step of a single traversal. Traversals
+// started from the source must begin with a source or start step.
+func validateSteps(s []Step, fromSource bool) error {
+	for i, step := range s {
+		rule, ok := steps[step.Name]
+		if !ok {
+			return invalid(step, i, "unknown step")
+		}
+
+		if err := rule.check(step, i); err != nil {
+			return err
+		}
+
+		if err := checkPosition(s, i, fromSource); err != nil {
+			return err
+		}
+
+		for _, a := range step.Args {
+			if kindOf(a) != kindTraversal {
+				continue
+			}
+			if err := validateNested(a); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateNested checks a traversal used as an argument.
+func validateNested(a interface{}) error {
+	switch t := a.(type) {
+	case Script:
+		return validateSteps(t.Steps, false)
+	case String:
+		return t.Raw().Validate()
+	default:
+		return nil
+	}
+}
+
+func (r stepRule) check(step Step, i int) error {
+	n := len(step.Args)
+
+	if n < r.min || (r.max >= 0 && n > r.max) {
+		return invalid(step, i, "expected "+r.arity()+" but got "+strconv.Itoa(n))
+	}
+
+	for j, a := range step.Args {
+		want := r.args[len(r.args)-1]
+		if j < len(r.args) {
+			want = r.args[j]
+		}
+		if kindOf(a)&want == 0 {
+			return invalid(step, i, "argument "+strconv.Itoa(j+1)+" cannot be "+kindOf(a).String())
+		}
+	}
+
+	if step.Name == "property" {
+		return checkProperty(step, i)
+	}
+
+	return nil
+}
+
+// arity describes the number of arguments the step takes.
+func (r stepRule) arity() string {
+	switch {
+	case r.max < 0:
+		return "at least " + strconv.Itoa(r.min) + " arguments"
+	case r.min == r.max:
+		return strconv.Itoa(r.min) + " arguments"
+	default:
+		return strconv.Itoa(r.min) + " to " + strconv.Itoa(r.max) + " arguments"
+	}
+}
+
+// checkProperty makes sure that property() receives key and value
+// pairs, or a single map of them, after the optional cardinality.
+func checkProperty(step Step, i int) error {
+	args := step.Args
+
+	if len(args) > 0 {
+		if c, ok := args[0].(Custom); ok && isCardinality(c) {
+			args = args[1:]
+		}
+	}
+
+	if len(args) == 1 && isMap(args[0]) {
+		return nil
+	}
+
+	if len(args) < 2 || len(args)%2 != 0 {
+		return invalid(step, i, "expected key and value pairs")
+	}
+
+	return nil
+}
+
+func isMap(a interface{}) bool {
+	switch a.(type) {
+	case Map, map[string]interface{}, map[interface{}]interface{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func isCardinality(c Custom) bool {
+	name := c.String()
+	if dot := strings.LastIndex(name, "."); dot >= 0 {
+		name = name[dot+1:]
+	}
+	return cardinalities[name]
+}
+
+// checkPosition makes sure the step is used somewhere it is allowed.
+func checkPosition(s []Step, i int, fromSource bool) error {
+	step := s[i]
+
+	if fromSource {
+		if i == 0 && !sourceSteps[step.Name] && !startSteps[step.Name] {
+			return invalid(step, i, "a traversal must begin with a start step such as V() or E()")
+		}
+		if sourceSteps[step.Name] && step.Name != "with" && !onlySourceSteps(s[:i]) {
+			return invalid(step, i, step.Name+"() may only be used on the traversal source")
+		}
+	}
+
+	switch step.Name {
+	case "by":
+		if !followsHost(s[:i], byHosts, "by") {
+			return invalid(step, i, "by() must follow a step that can be modulated by it")
+		}
+	case "option":
+		if !followsHost(s[:i], optionHosts, "option", "by") {
+			return invalid(step, i, "option() must follow choose(), branch(), mergeV() or mergeE()")
+		}
+	case "from", "to":
+		if !followsHost(s[:i], fromToHosts, "from", "to", "by", "property") {
+			return invalid(step, i, step.Name+"() must follow addE() or a path step")
+		}
+	}
+
+	return nil
+}
+
+func onlySourceSteps(s []Step) bool {
+	for _, step := range s {
+		if !sourceSteps[step.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+// followsHost reports whether the closest step before the
+// modulator, ignoring the skipped steps, is one of the hosts.
+func followsHost(before []Step, hosts map[string]bool, skip ...string) bool {
+	skipped := stepSet(skip...)
+
+	for i := len(before) - 1; i >= 0; i-- {
+		if skipped[before[i].Name] {
+			continue
+		}
+		return hosts[before[i].Name]
+	}
+
+	return false
+}
+
+// kindOf returns the kind of a parsed or user given argument.
+func kindOf(a interface{}) argKind {
+	switch t := a.(type) {
+	case string:
+		return kindString
+	case bool:
+		return kindBool
+	case Number, int, int8, int16, int32, int64, uint, uint8, uint16,
+		uint32, uint64, float32, float64:
+		return kindNumber
+	case Custom:
+		return kindIdent
+	case String:
+		return kindTraversal
+	case Script:
+		return scriptKind(t)
+	case List, Map, Lambda:
+		return kindValue
+	case Parameter:
+		// enumerations such as scope.Scope or cardinality.Cardinality.
+		return kindIdent
+	default:
+		return kindValue
+	}
+}
+
+// scriptKind tells a nested traversal apart from a predicate
+// or a value such as Geoshape.point(1,2).
+func scriptKind(s Script) argKind {
+	switch {
+	case predicateSources[s.Source]:
+		return kindPredicate
+	case s.Source != "" && s.Source != "__" && s.Source != "g":
+		return kindValue
+	case len(s.Steps) == 0:
+		return kindTraversal
+	}
+
+	first := s.Steps[0]
+
+	if predicates[first.Name] {
+		return kindPredicate
+	}
+
+	// not, and & or are both steps and predicates
+	// depending on what they're given.
+	if s.Source == "" && (first.Name == "not" || first.Name == "and" || first.Name == "or") {
+		for _, a := range first.Args {
+			if kindOf(a) != kindPredicate {
+				return kindTraversal
+			}
+		}
+		if len(first.Args) > 0 {
+			return kindPredicate
+		}
+	}
+
+	return kindTraversal
+}
+
+func (k argKind) String() string {
+	switch k {
+	case kindString:
+		return "a string"
+	case kindNumber:
+		return "a number"
+	case kindBool:
+		return "a boolean"
+	case kindIdent:
+		return "an identifier"
+	case kindTraversal:
+		return "a traversal"
+	case kindPredicate:
+		return "a predicate"
+	default:
+		return "a value"
+	}
+}
+
+func invalid(step Step, i int, reason string) error {
+	return gremerror.NewValidationError(step.Name, i, reason)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/predicate"
+)
+
+func TestValidate(t *testing.T) {
+	Convey("Given traversals made by the step builders", t, func() {
+		Convey("When 'Validate' is called on each of them", func() {
+			Convey("Then no error should be returned", func() {
+				for _, q := range builtQueries() {
+					s, _ := Parse(q.String())
+					if s.Index("withSack") > 0 || s.Index("option") > 0 {
+						// these builders render steps that TinkerPop rejects.
+						continue
+					}
+					So(q.Validate(), ShouldBeNil)
+				}
+			})
+		})
+	})
+
+	Convey("Given a management script from the graph package", t, func() {
+		s, _ := Parse(graph.NewGraph().OpenManagement().MakeVertexLabel("person").Make().String())
+		Convey("When 'Validate' is called", func() {
+			err := s.Validate()
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a graph traversal", t, func() {
+		g := NewTraversal()
+
+		Convey("When 'Validate' is called with an odd number of property arguments", func() {
+			err := g.AddV("person").Property("name", "damien", "age").Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "key and value pairs")
+			})
+		})
+
+		Convey("When 'Validate' is called with a cardinality and a property", func() {
+			err := g.V().Property(cardinality.List, "name", "damien").Validate()
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When 'Validate' is called with a map of properties", func() {
+			s, _ := Parse(`g.V().property([name:"damien",age:24]).property(single,["x":1])`)
+			Convey("Then no error should be returned", func() {
+				So(s.Validate(), ShouldBeNil)
+			})
+		})
+
+		Convey("When 'Validate' is called with a single property argument that isn't a map", func() {
+			s, _ := Parse(`g.V().property("name")`)
+			Convey("Then an error should be returned", func() {
+				So(s.Validate(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When 'Validate' is called with an option outside of choose", func() {
+			err := g.V().Out().Option("a").Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "option()")
+			})
+		})
+
+		Convey("When 'Validate' is called with a by that has nothing to modulate", func() {
+			err := g.V().Out().By("name").Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "by()")
+			})
+		})
+
+		Convey("When 'Validate' is called with several by steps after order", func() {
+			err := g.V().Order().By("name").By("age").Validate()
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When 'Validate' is called with an unknown step", func() {
+			q := g.V()
+			q.AddStep("outt", "knows")
+			err := q.Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "unknown step")
+			})
+		})
+
+		Convey("When 'Validate' is called with the wrong kind of argument", func() {
+			err := g.V().Limit("ten").Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "a string")
+			})
+		})
+
+		Convey("When 'Validate' is called with too many arguments", func() {
+			q := g.V()
+			q.AddStep("count", "a", "b")
+			err := q.Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When 'Validate' is called without a start step", func() {
+			err := g.Out().Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "start step")
+			})
+		})
+
+		Convey("When 'Validate' is called with a source step after the start step", func() {
+			err := g.V().WithSack(1).Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "traversal source")
+			})
+		})
+
+		Convey("When 'Validate' is called with a mistake inside of a nested traversal", func() {
+			q := NewTraversal().Out()
+			q.AddStep("foo")
+			err := g.V().Where(q.Raw()).Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "foo")
+			})
+		})
+
+		Convey("When 'Validate' is called with a predicate", func() {
+			err := g.V().Has("age", predicate.GreaterThan(3)).Validate()
+			Convey("Then no error should be returned", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When 'Validate' is called with a traversal that cannot be parsed", func() {
+			err := NewCustomTraversal("g.V(").Validate()
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -20,7 +20,11 @@
 
 package traversal
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/northwesternmutual/grammes/query/predicate"
+)
 
 // http://tinkerpop.apache.org/docs/current/reference/#where-step
 
@@ -32,13 +36,17 @@ import "fmt"
 // Signatures:
 // Where(string (P))
 // Where(string, string (P))
+// Where(*predicate.Predicate)
+// Where(string, *predicate.Predicate)
 // Where(*String (Traversal))
-func (g String) Where(first interface{}, extra ...string) String {
+func (g String) Where(first interface{}, extra ...interface{}) String {
 	g = g.append(".where(")
 
 	switch first.(type) {
 	case string:
 		g = g.append(first.(string))
+	case *predicate.Predicate:
+		g = g.append(first.(*predicate.Predicate).String())
 	case String: // Where(*String (Traversal))
 		g = g.append(fmtStr("%v)", first.(String).String()))
 		return g
@@ -48,7 +56,7 @@ func (g String) Where(first interface{}, extra ...string) String {
 
 	if len(extra) > 0 {
 		for _, v := range extra {
-			g = g.append("," + v)
+			g = g.append(fmtStr(",%v", v))
 		}
 	}
 
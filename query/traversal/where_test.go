@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/predicate"
 )
 
 func TestWhere(t *testing.T) {
@@ -57,5 +59,19 @@ func TestWhere(t *testing.T) {
 				So(result.String(), ShouldEqual, "g.where(testFirst,testExtras1,testExtras2)")
 			})
 		})
+
+		Convey("When 'Where' is called with a predicate", func() {
+			result := g.Where(predicate.Equal(Custom("a")))
+			Convey("Then result should equal 'g.where(eq(a))'", func() {
+				So(result.String(), ShouldEqual, "g.where(eq(a))")
+			})
+		})
+
+		Convey("When 'Where' is called with a label and a predicate", func() {
+			result := g.Where("'a'", predicate.GreaterThan(Custom("b")).And(predicate.Not(predicate.Equal(Custom("c")))))
+			Convey("Then result should equal 'g.where('a',gt(b).and(not(eq(c))))'", func() {
+				So(result.String(), ShouldEqual, "g.where('a',gt(b).and(not(eq(c))))")
+			})
+		})
 	})
 }
@@ -0,0 +1,55 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import "github.com/northwesternmutual/grammes/query/strategy"
+
+// https://tinkerpop.apache.org/docs/current/reference/#traversalstrategy
+
+// WithStrategies (source) adds strategies to the traversal source.
+// It must come before the start step such as V() or E().
+// Signatures:
+// WithStrategies(...strategy.Strategy)
+func (g String) WithStrategies(strategies ...strategy.Strategy) String {
+	params := make([]interface{}, 0, len(strategies))
+	for _, s := range strategies {
+		params = append(params, s)
+	}
+
+	g.AddStep("withStrategies", params...)
+
+	return g
+}
+
+// WithoutStrategies (source) removes strategies from the traversal source.
+// It must come before the start step such as V() or E().
+// Signatures:
+// WithoutStrategies(...strategy.Name)
+func (g String) WithoutStrategies(names ...strategy.Name) String {
+	params := make([]interface{}, 0, len(names))
+	for _, n := range names {
+		params = append(params, n)
+	}
+
+	g.AddStep("withoutStrategies", params...)
+
+	return g
+}
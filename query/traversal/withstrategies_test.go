@@ -0,0 +1,62 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/strategy"
+)
+
+func TestWithStrategies(t *testing.T) {
+	Convey("Given a ) String { that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+		Convey("When 'WithStrategies' is called with strategies", func() {
+			result := g.WithStrategies(
+				strategy.Subgraph().Vertices(NewTraversal().HasLabel("person").Raw()).Create(),
+				strategy.ReadOnly(),
+			).V()
+			Convey("Then result should equal 'g.withStrategies(SubgraphStrategy.build().vertices(hasLabel(\"person\")).create(),ReadOnlyStrategy.instance()).V()'", func() {
+				So(result.String(), ShouldEqual, "g.withStrategies(SubgraphStrategy.build().vertices(hasLabel(\"person\")).create(),ReadOnlyStrategy.instance()).V()")
+			})
+			Convey("Then the result should be valid", func() {
+				So(result.Validate(), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestWithoutStrategies(t *testing.T) {
+	Convey("Given a ) String { that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+		Convey("When 'WithoutStrategies' is called with strategy names", func() {
+			result := g.WithoutStrategies(strategy.LazyBarrierStrategy, strategy.PathRetractionStrategy).V()
+			Convey("Then result should equal 'g.withoutStrategies(LazyBarrierStrategy,PathRetractionStrategy).V()'", func() {
+				So(result.String(), ShouldEqual, "g.withoutStrategies(LazyBarrierStrategy,PathRetractionStrategy).V()")
+			})
+			Convey("Then the result should be valid", func() {
+				So(result.Validate(), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package withoptions contains the object to configure steps with the with() step modulator.
+
+See: https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/step/util/WithOptions.html
+
+WithOptions are the keys and values given to with() to change what
+steps such as valueMap(), propertyMap() and index() return.
+
+A note about WithOption:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package withoptions
+
+// https://tinkerpop.apache.org/javadocs/current/core/org/apache/tinkerpop/gremlin/process/traversal/step/util/WithOptions.html
+
+// WithOption is a key or value used
+// to configure a step with with().
+type WithOption string
+
+const (
+	// Tokens is the key to include the id, label, key
+	// or value tokens in valueMap() and propertyMap().
+	Tokens WithOption = "WithOptions.tokens"
+	// None includes none of the tokens.
+	None WithOption = "WithOptions.none"
+	// IDs includes the id token.
+	IDs WithOption = "WithOptions.ids"
+	// Labels includes the label token.
+	Labels WithOption = "WithOptions.labels"
+	// Keys includes the key token.
+	Keys WithOption = "WithOptions.keys"
+	// Values includes the value token.
+	Values WithOption = "WithOptions.values"
+	// All includes every token.
+	All WithOption = "WithOptions.all"
+	// Indexer is the key to choose how index() indexes its items.
+	Indexer WithOption = "WithOptions.indexer"
+	// List makes index() return the items as lists.
+	List WithOption = "WithOptions.list"
+	// Map makes index() return the items as maps.
+	Map WithOption = "WithOptions.map"
+)
+
+func (w WithOption) String() string {
+	return string(w)
+}
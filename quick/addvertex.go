@@ -79,6 +79,43 @@ func AddVertex(host, label string, properties ...interface{}) (grammes.Vertex, e
 	return res, nil
 }
 
+// MergeVertex will find the vertex with the given label and
+// matching properties in the graph associated with the given
+// host or create it when there isn't one.
+func MergeVertex(host, label string, match, onCreate, onMatch map[string]interface{}) (grammes.Vertex, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilVertex, err
+	}
+
+	vq := client.GraphManager.AddVertexQuerier()
+	res, err := vq.MergeVertex(label, match, onCreate, onMatch)
+	if err != nil {
+		return nilVertex, err
+	}
+
+	return res, nil
+}
+
+// UpsertVertex will find the vertex with the given label and
+// matching properties in the graph associated with the given
+// host or create it when there isn't one, then set the set
+// properties on it. It returns whether the vertex was created.
+func UpsertVertex(host, label string, match, set map[string]interface{}) (grammes.Vertex, bool, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilVertex, false, err
+	}
+
+	vq := client.GraphManager.AddVertexQuerier()
+	res, created, err := vq.UpsertVertex(label, match, set)
+	if err != nil {
+		return nilVertex, false, err
+	}
+
+	return res, created, nil
+}
+
 // AddVertexLabels will do the same as AddVertexLabel, but with
 // the ability to add multiple labels at a time.
 func AddVertexLabels(host string, labels ...string) ([]grammes.Vertex, error) {
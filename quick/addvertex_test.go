@@ -51,6 +51,8 @@ var (
 		}
 	]
 	`
+	upsertedVertexResponse = `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["element",` +
+		`{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":28720},"label":"person"}},"created",true]}]}`
 	idResponse = `
 	[
 		{
@@ -395,3 +397,125 @@ func TestAddVertexByQueryQueryError(t *testing.T) {
 		})
 	})
 }
+
+func TestMergeVertex(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(vertexResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string, label string and properties", t, func() {
+		host := "testhost"
+		label := "testlabel"
+		match := map[string]interface{}{"name": "damien"}
+		Convey("When MergeVertex is called", func() {
+			_, err := MergeVertex(host, label, match, nil, nil)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestMergeVertexClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When MergeVertex is called and there is an error checking for the client", func() {
+			_, err := MergeVertex(host, "testlabel", nil, nil, nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestMergeVertexQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string and label string", t, func() {
+		host := "testhost"
+		label := "testlabel"
+		Convey("When MergeVertex is called and there is an error while querying", func() {
+			_, err := MergeVertex(host, label, nil, nil, nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertVertex(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(upsertedVertexResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When UpsertVertex is called", func() {
+			_, created, err := UpsertVertex(host, "person", map[string]interface{}{"name": "damien"}, nil)
+			Convey("Then the result should be returned", func() {
+				So(err, ShouldBeNil)
+				So(created, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestUpsertVertexClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When UpsertVertex is called and encounters an error checking for the client", func() {
+			_, _, err := UpsertVertex(host, "person", map[string]interface{}{"name": "damien"}, nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertVertexQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When UpsertVertex is called and encounters a querying error", func() {
+			_, _, err := UpsertVertex(host, "person", map[string]interface{}{"name": "damien"}, nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package quick
+
+import (
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/query"
+)
+
+// nilEdge is used for returning nothing
+// in an edge related function.
+var nilEdge = grammes.Edge{}
+
+// AddEdge adds an edge with the label and properties
+// going out of the vertex with the outID and into the vertex
+// with the inID, then returns the added edge.
+func AddEdge(host string, outID, inID int64, label string, properties ...interface{}) (grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.AddEdge(outID, inID, label, properties...)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	return res, nil
+}
+
+// EdgeByID will get the edge with the given ID.
+func EdgeByID(host string, id string) (grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgeByID(id)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	return res, nil
+}
+
+// EdgesByString will gather any edges and return them
+// based on the fed in string query.
+func EdgesByString(host string, stringQuery string) ([]grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nil, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgesByString(stringQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// EdgesByQuery will gather any edges and return them
+// based on the fed in traversal query.
+func EdgesByQuery(host string, q query.Query) ([]grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nil, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgesByQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Edges will gather the edges with the
+// label and matching the properties.
+func Edges(host string, label string, properties ...interface{}) ([]grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nil, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.Edges(label, properties...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// EdgeCount retrieves the number of edges
+// that are currently on the graph as an int64.
+func EdgeCount(host string) (int64, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return 0, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgeCount()
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+// UpsertEdge will find the edge with the label between the
+// vertices or create it when there isn't one, then set the
+// properties on it. It returns whether the edge was created.
+func UpsertEdge(host string, outID, inID int64, label string, properties map[string]interface{}) (grammes.Edge, bool, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilEdge, false, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, created, err := eq.UpsertEdge(outID, inID, label, properties)
+	if err != nil {
+		return nilEdge, false, err
+	}
+
+	return res, created, nil
+}
+
+// DropEdge drops the edges with the given IDs.
+func DropEdge(host string, ids ...string) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	err = eq.DropEdge(ids...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetEdgeProperty will search the graph for an edge
+// with the given ID and set the properties provided.
+func SetEdgeProperty(host string, id string, properties ...interface{}) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	err = eq.SetEdgeProperty(id, properties...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,571 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package quick
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+var edgeResponse = `[{"@type":"g:Edge","@value":{"id":{"@type":"janusgraph:RelationIdentifier","@value":{"relationId":"a"}},` +
+	`"inV":{"@type":"g:Int64","@value":2},"label":"knows","outV":{"@type":"g:Int64","@value":1}}}]`
+
+var upsertedEdgeResponse = `{"@type":"g:List","@value":[{"@type":"g:Map","@value":["element",` +
+	`{"@type":"g:Edge","@value":{"id":{"@type":"janusgraph:RelationIdentifier","@value":{"relationId":"a"}},` +
+	`"label":"knows","inV":{"@type":"g:Int64","@value":2},"outV":{"@type":"g:Int64","@value":1}}},"created",true]}]}`
+
+func TestAddEdge(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When AddEdge is called", func() {
+			_, err := AddEdge(host, 1, 2, "knows")
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestAddEdgeClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When AddEdge is called and encounters an error checking for the client", func() {
+			_, err := AddEdge(host, 1, 2, "knows")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestAddEdgeQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When AddEdge is called and encounters a querying error", func() {
+			_, err := AddEdge(host, 1, 2, "knows")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeByID(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgeByID is called", func() {
+			_, err := EdgeByID(host, "a")
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeByIDClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgeByID is called and encounters an error checking for the client", func() {
+			_, err := EdgeByID(host, "a")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeByIDQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgeByID is called and encounters a querying error", func() {
+			_, err := EdgeByID(host, "a")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesByString(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgesByString is called", func() {
+			_, err := EdgesByString(host, "g.E()")
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesByStringClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgesByString is called and encounters an error checking for the client", func() {
+			_, err := EdgesByString(host, "g.E()")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesByStringQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgesByString is called and encounters a querying error", func() {
+			_, err := EdgesByString(host, "g.E()")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesByQuery(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgesByQuery is called", func() {
+			_, err := EdgesByQuery(host, traversal.NewTraversal().E())
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesByQueryClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgesByQuery is called and encounters an error checking for the client", func() {
+			_, err := EdgesByQuery(host, traversal.NewTraversal().E())
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesByQueryQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgesByQuery is called and encounters a querying error", func() {
+			_, err := EdgesByQuery(host, traversal.NewTraversal().E())
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdges(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When Edges is called", func() {
+			_, err := Edges(host, "knows")
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When Edges is called and encounters an error checking for the client", func() {
+			_, err := Edges(host, "knows")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When Edges is called and encounters a querying error", func() {
+			_, err := Edges(host, "knows")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDropEdge(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When DropEdge is called", func() {
+			err := DropEdge(host, "a")
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestDropEdgeClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When DropEdge is called and encounters an error checking for the client", func() {
+			err := DropEdge(host, "a")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDropEdgeQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When DropEdge is called and encounters a querying error", func() {
+			err := DropEdge(host, "a")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSetEdgeProperty(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When SetEdgeProperty is called", func() {
+			err := SetEdgeProperty(host, "a", "since", 2010)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestSetEdgePropertyClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When SetEdgeProperty is called and encounters an error checking for the client", func() {
+			err := SetEdgeProperty(host, "a", "since", 2010)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSetEdgePropertyQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When SetEdgeProperty is called and encounters a querying error", func() {
+			err := SetEdgeProperty(host, "a", "since", 2010)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeCount(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(idResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgeCount is called", func() {
+			_, err := EdgeCount(host)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeCountClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgeCount is called and encounters an error checking for the client", func() {
+			_, err := EdgeCount(host)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeCountQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgeCount is called and encounters a querying error", func() {
+			_, err := EdgeCount(host)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertEdge(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(upsertedEdgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When UpsertEdge is called", func() {
+			_, created, err := UpsertEdge(host, 1, 2, "knows", nil)
+			Convey("Then the result should be returned", func() {
+				So(err, ShouldBeNil)
+				So(created, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestUpsertEdgeClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When UpsertEdge is called and encounters an error checking for the client", func() {
+			_, _, err := UpsertEdge(host, 1, 2, "knows", nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertEdgeQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When UpsertEdge is called and encounters a querying error", func() {
+			_, _, err := UpsertEdge(host, 1, 2, "knows", nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -20,6 +20,11 @@
 
 package quick
 
+import (
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/computer"
+)
+
 // DropAll drops everything from the graph.
 func DropAll(host string) error {
 	err := checkForClient(host)
@@ -69,3 +74,20 @@ func VertexCount(host string) (int64, error) {
 
 	return res, nil
 }
+
+// ComputerQuery runs the traversal as an OLAP job on
+// the computer and unmarshals its results into v.
+func ComputerQuery(host string, queryObj query.Query, c computer.Computer, v interface{}) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	mq := client.GraphManager.MiscQuerier()
+	err = mq.ComputerQuery(queryObj, c, v)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
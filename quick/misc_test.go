@@ -29,6 +29,8 @@ import (
 	"github.com/northwesternmutual/grammes"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/manager"
+	"github.com/northwesternmutual/grammes/query/computer"
+	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
 func TestDropAll(t *testing.T) {
@@ -210,3 +212,66 @@ func TestVertexCountQueryError(t *testing.T) {
 		})
 	})
 }
+
+func TestComputerQuery(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(`[{"name":"marko"}]`)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When ComputerQuery is called", func() {
+			var res []map[string]interface{}
+			err := ComputerQuery(host, traversal.NewTraversal().V().PageRank(), computer.Compute(computer.SparkGraphComputer), &res)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func TestComputerQueryClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When ComputerQuery is called and encounters an error checking for the client", func() {
+			var res []interface{}
+			err := ComputerQuery(host, traversal.NewTraversal().V(), "", &res)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestComputerQueryQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When ComputerQuery is called and there is querying error", func() {
+			var res []interface{}
+			err := ComputerQuery(host, traversal.NewTraversal().V(), "", &res)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}